@@ -0,0 +1,127 @@
+package ical
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/parsers/eventcalendar"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// maxLookback is the maximum amount of months before Args.From that Export
+// fetches to find where the events that started before it actually started.
+const maxLookback = 3
+
+// Args is used by Export.
+type Args struct {
+	// From is the first month to be exported. Only its year and month are
+	// used.
+	//
+	// From must not be zero. Otherwise, parsers.ErrInvalidArgs is returned.
+	From time.Time
+
+	// Months is the amount of months to be exported, starting at From.
+	//
+	// Months must be greater than 0. Otherwise, parsers.ErrInvalidArgs is
+	// returned.
+	Months int
+
+	// Options configures the exported feed.
+	Options Options
+}
+
+// Export fetches the months of the tibia.com Event Calendar described by args
+// and writes them to w as an iCalendar feed, see Encode.
+//
+// Every month is a different request, and all of them go through opts, so
+// the RateLimiter is honored between them. Nothing is written to w if any of
+// the months could not be fetched.
+//
+// Events that started before the first month are cut at the edge of its
+// calendar, so up to 3 previous months are fetched as well to find where they
+// actually started. That keeps their UIDs the same across exports of
+// different, overlapping months.
+func Export(
+	ctx context.Context,
+	w io.Writer,
+	args Args,
+	opts parsers.Options,
+) error {
+	if args.From.IsZero() {
+		return fmt.Errorf("ical: from is zero: %w", parsers.ErrInvalidArgs)
+	}
+
+	if args.Months <= 0 {
+		return fmt.Errorf(
+			"ical: invalid months %d: %w", args.Months, parsers.ErrInvalidArgs,
+		)
+	}
+
+	var p eventcalendar.Parser
+
+	return export(w, args, func(month time.Time) (tibia.EventCalendar, error) {
+		return p.Parse(ctx, eventcalendar.Args{
+			Year:  month.Year(),
+			Month: month.Month(),
+		}, opts)
+	})
+}
+
+// export writes the months described by args to w, getting the calendar of
+// every month from get.
+func export(
+	w io.Writer,
+	args Args,
+	get func(month time.Time) (tibia.EventCalendar, error),
+) error {
+	var (
+		calendars = make([]tibia.EventCalendar, 0, args.Months)
+		first     = time.Date(
+			args.From.Year(), args.From.Month(), 1, 0, 0, 0, 0, time.UTC,
+		)
+	)
+
+	for i, month := 0, first; i < args.Months; i++ {
+		calendar, err := get(month)
+		if err != nil {
+			return fmt.Errorf("ical: %s: %w", month.Format("Jan 2006"), err)
+		}
+
+		calendars = append(calendars, calendar)
+		month = month.AddDate(0, 1, 0)
+	}
+
+	var (
+		history []tibia.EventCalendar
+		oldest  = calendars[0]
+	)
+
+	for i, month := 0, first; i < maxLookback && startsEarlier(oldest); i++ {
+		month = month.AddDate(0, -1, 0)
+
+		calendar, err := get(month)
+		if err != nil {
+			return fmt.Errorf("ical: %s: %w", month.Format("Jan 2006"), err)
+		}
+
+		history = append([]tibia.EventCalendar{calendar}, history...)
+		oldest = calendar
+	}
+
+	return encode(w, history, calendars, args.Options)
+}
+
+// startsEarlier reports whether any event of calendar started before its
+// month, in which case it may have been cut at the edge of the calendar.
+func startsEarlier(calendar tibia.EventCalendar) bool {
+	first := time.Date(calendar.Year, calendar.Month, 1, 0, 0, 0, 0, time.UTC)
+	for _, event := range calendar.Events {
+		if event.Start.Before(first) {
+			return true
+		}
+	}
+	return false
+}
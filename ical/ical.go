@@ -0,0 +1,345 @@
+// Package ical provides an exporter of the tibia.com Event Calendar to the
+// iCalendar format, as defined by RFC 5545.
+//
+// The resulting feed can be imported or subscribed to by calendar clients,
+// such as Google Calendar and Outlook. Every event has a stable UID, so
+// clients update the events they already know instead of duplicating them
+// when the feed is refreshed.
+//
+// To export a feed, either call Export, which fetches the months of the Event
+// Calendar with the eventcalendar package, or call Encode with calendars that
+// were already parsed.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	prodID = "-//tibia-crawler//Event Calendar//EN"
+
+	// uidDomain is the right-hand side of the UIDs of the events, as
+	// recommended by RFC 5545.
+	uidDomain = "tibia-crawler"
+
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405Z"
+
+	// maxLineLength is the maximum length of a content line, in octets,
+	// excluding the line break.
+	maxLineLength = 75
+
+	serverSaveSummary  = "Server Save"
+	serverSaveDuration = 10 * time.Minute
+)
+
+// Options configures the feed written by Encode.
+type Options struct {
+	// ServerSave adds an event for the daily server save to every day of the
+	// months of the calendars.
+	ServerSave bool
+
+	// Stamp is the time the feed was created, and it is written as the
+	// DTSTAMP of every event.
+	//
+	// If Stamp is zero, the current time is used.
+	Stamp time.Time
+}
+
+// Encode writes the events of calendars to w as an iCalendar feed.
+//
+// tibia.com displays some days of the adjacent months on every calendar, so
+// the same event may be present in more than one of the calendars. Such
+// events are merged, and so are events that are cut at the edges of the
+// calendars.
+//
+// Events are written as all-day events. Their UIDs are made of their name,
+// their description and their first day, so an event keeps its UID as long as
+// its first day is displayed on one of the calendars. Export takes care of
+// that for events that started before the exported months.
+func Encode(w io.Writer, calendars []tibia.EventCalendar, opts Options) error {
+	return encode(w, nil, calendars, opts)
+}
+
+// encode writes the events of calendars to w, like Encode.
+//
+// history are calendars of the months before calendars, which are only used
+// to find the first day of the events of calendars. Events that are not
+// displayed on calendars are not written.
+func encode(
+	w io.Writer,
+	history, calendars []tibia.EventCalendar,
+	opts Options,
+) error {
+	stamp := opts.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	e := encoder{
+		w:     bufio.NewWriter(w),
+		stamp: stamp.UTC().Format(dateTimeLayout),
+	}
+
+	e.line("BEGIN:VCALENDAR")
+	e.line("VERSION:2.0")
+	e.line("PRODID:" + prodID)
+	e.line("CALSCALE:GREGORIAN")
+
+	for _, event := range mergeEvents(history, calendars) {
+		e.event(event)
+	}
+
+	if opts.ServerSave {
+		for _, day := range monthDays(calendars) {
+			e.serverSave(day)
+		}
+	}
+
+	e.line("END:VCALENDAR")
+
+	if err := e.w.Flush(); err != nil {
+		return fmt.Errorf("ical: failed to write feed: %w", err)
+	}
+
+	return nil
+}
+
+// mergeEvents merges the events of history and calendars with the same name
+// and description that are active on consecutive days, sorted by their first
+// day and name.
+//
+// Only the events displayed on calendars are returned.
+func mergeEvents(history, calendars []tibia.EventCalendar) []tibia.Event {
+	type key struct{ name, desc string }
+
+	var (
+		keys []key
+		days = make(map[key]map[time.Time]bool)
+
+		// shown holds the days every event is displayed on calendars.
+		shown = make(map[key]map[time.Time]bool)
+	)
+
+	add := func(calendars []tibia.EventCalendar, isShown bool) {
+		for _, calendar := range calendars {
+			for _, event := range calendar.Events {
+				k := key{event.Name, event.Description}
+				if days[k] == nil {
+					keys = append(keys, k)
+					days[k] = make(map[time.Time]bool)
+					shown[k] = make(map[time.Time]bool)
+				}
+
+				for _, day := range event.Days {
+					days[k][truncateDay(day)] = true
+					if isShown {
+						shown[k][truncateDay(day)] = true
+					}
+				}
+			}
+		}
+	}
+
+	add(history, false)
+	add(calendars, true)
+
+	var events []tibia.Event
+	for _, k := range keys {
+		sorted := make([]time.Time, 0, len(days[k]))
+		for day := range days[k] {
+			sorted = append(sorted, day)
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].Before(sorted[j])
+		})
+
+		start := 0
+		for i := range sorted {
+			last := i == len(sorted)-1
+			if !last && sorted[i].AddDate(0, 0, 1).Equal(sorted[i+1]) {
+				continue
+			}
+
+			span := sorted[start : i+1]
+			start = i + 1
+
+			if !isShown(span, shown[k]) {
+				continue
+			}
+
+			events = append(events, tibia.Event{
+				Name:        k.name,
+				Description: k.desc,
+				Start:       span[0],
+				End:         span[len(span)-1],
+				Days:        span,
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Start.Equal(events[j].Start) {
+			return events[i].Start.Before(events[j].Start)
+		}
+		return events[i].Name < events[j].Name
+	})
+
+	return events
+}
+
+// isShown reports whether any of days is in shown.
+func isShown(days []time.Time, shown map[time.Time]bool) bool {
+	for _, day := range days {
+		if shown[day] {
+			return true
+		}
+	}
+	return false
+}
+
+// monthDays returns every day of the months of calendars, sorted and without
+// duplicates.
+func monthDays(calendars []tibia.EventCalendar) []time.Time {
+	seen := make(map[time.Time]bool)
+
+	var days []time.Time
+	for _, calendar := range calendars {
+		day := time.Date(calendar.Year, calendar.Month, 1, 0, 0, 0, 0, time.UTC)
+		for ; day.Month() == calendar.Month; day = day.AddDate(0, 0, 1) {
+			if !seen[day] {
+				seen[day] = true
+				days = append(days, day)
+			}
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+
+	return days
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+type encoder struct {
+	w     *bufio.Writer
+	stamp string
+}
+
+func (e *encoder) event(event tibia.Event) {
+	e.line("BEGIN:VEVENT")
+	e.line("UID:" + uid(event) + "@" + uidDomain)
+	e.line("DTSTAMP:" + e.stamp)
+	e.line("DTSTART;VALUE=DATE:" + event.Start.Format(dateLayout))
+	// The end date of all-day events is exclusive.
+	e.line("DTEND;VALUE=DATE:" +
+		event.End.AddDate(0, 0, 1).Format(dateLayout))
+	e.line("SUMMARY:" + escape(event.Name))
+	if event.Description != "" {
+		e.line("DESCRIPTION:" + escape(event.Description))
+	}
+	e.line("TRANSP:TRANSPARENT")
+	e.line("END:VEVENT")
+}
+
+func (e *encoder) serverSave(day time.Time) {
+	ss := tibia.ServerSaveTime(day)
+
+	e.line("BEGIN:VEVENT")
+	e.line("UID:" + day.Format(dateLayout) + "-server-save@" + uidDomain)
+	e.line("DTSTAMP:" + e.stamp)
+	e.line("DTSTART:" + ss.Format(dateTimeLayout))
+	e.line("DTEND:" + ss.Add(serverSaveDuration).Format(dateTimeLayout))
+	e.line("SUMMARY:" + serverSaveSummary)
+	e.line("TRANSP:TRANSPARENT")
+	e.line("END:VEVENT")
+}
+
+// uid returns the left-hand side of the UID of event, such as
+// "20230707-double-xp-1a2b3c4d".
+//
+// Events with the same name may start on the same day, i.e. with different
+// descriptions, so the name and the description are hashed into the UID as
+// well.
+func uid(event tibia.Event) string {
+	h := fnv.New32a()
+	_, _ = io.WriteString(h, event.Name)
+	_, _ = h.Write([]byte{0})
+	_, _ = io.WriteString(h, event.Description)
+
+	return fmt.Sprintf(
+		"%s-%s-%08x",
+		event.Start.Format(dateLayout), slug(event.Name), h.Sum32(),
+	)
+}
+
+// line writes a content line, folding it into lines of at most maxLineLength
+// octets, as required by RFC 5545.
+func (e *encoder) line(s string) {
+	limit := maxLineLength
+	for len(s) > limit {
+		// Never split a multi-octet character.
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+
+		_, _ = e.w.WriteString(s[:cut])
+		_, _ = e.w.WriteString("\r\n ")
+		s = s[cut:]
+
+		// The leading space of the continuation lines counts towards
+		// their length.
+		limit = maxLineLength - 1
+	}
+
+	_, _ = e.w.WriteString(s)
+	_, _ = e.w.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escape escapes s to be used as a TEXT value.
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// slug returns s in lower case, with every sequence of characters that are
+// not ASCII letters or digits replaced by a single "-".
+func slug(s string) string {
+	var (
+		b    strings.Builder
+		dash bool
+	)
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
@@ -0,0 +1,363 @@
+package ical
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2023, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func event(name, desc string, days ...time.Time) tibia.Event {
+	return tibia.Event{
+		Name:        name,
+		Description: desc,
+		Start:       days[0],
+		End:         days[len(days)-1],
+		Days:        days,
+	}
+}
+
+var stamp = time.Date(2023, time.July, 5, 12, 30, 0, 0, time.UTC)
+
+func TestEncode(t *testing.T) {
+	calendars := []tibia.EventCalendar{
+		{
+			Year:  2023,
+			Month: time.July,
+			Events: []tibia.Event{
+				event(
+					"Double XP", "Gain double experience, skill; and more!",
+					day(time.July, 7), day(time.July, 8),
+				),
+				event(
+					"Rapid Respawn", "Creatures respawn faster.",
+					day(time.July, 30), day(time.July, 31),
+					day(time.August, 1),
+				),
+			},
+		},
+		{
+			Year:  2023,
+			Month: time.August,
+			Events: []tibia.Event{
+				event(
+					"Rapid Respawn", "Creatures respawn faster.",
+					day(time.July, 31), day(time.August, 1),
+					day(time.August, 2),
+				),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, calendars, Options{Stamp: stamp}); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//tibia-crawler//Event Calendar//EN",
+		"CALSCALE:GREGORIAN",
+		"BEGIN:VEVENT",
+		"UID:20230707-double-xp-b9d40a2f@tibia-crawler",
+		"DTSTAMP:20230705T123000Z",
+		"DTSTART;VALUE=DATE:20230707",
+		"DTEND;VALUE=DATE:20230709",
+		"SUMMARY:Double XP",
+		`DESCRIPTION:Gain double experience\, skill\; and more!`,
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:20230730-rapid-respawn-324f7eca@tibia-crawler",
+		"DTSTAMP:20230705T123000Z",
+		"DTSTART;VALUE=DATE:20230730",
+		"DTEND;VALUE=DATE:20230803",
+		"SUMMARY:Rapid Respawn",
+		"DESCRIPTION:Creatures respawn faster.",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	if got := buf.String(); got != want {
+		t.Errorf("Wrong feed\nwant:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestEncodeServerSave(t *testing.T) {
+	calendars := []tibia.EventCalendar{{Year: 2023, Month: time.October}}
+
+	var buf bytes.Buffer
+	err := Encode(&buf, calendars, Options{ServerSave: true, Stamp: stamp})
+	if err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+
+	feed := buf.String()
+
+	if n := strings.Count(feed, "SUMMARY:Server Save\r\n"); n != 31 {
+		t.Errorf("Wrong amount of server saves\nwant: %d\ngot: %d", 31, n)
+	}
+
+	for _, want := range []string{
+		"UID:20231028-server-save@tibia-crawler\r\n" +
+			"DTSTAMP:20230705T123000Z\r\n" +
+			"DTSTART:20231028T080000Z\r\n" +
+			"DTEND:20231028T081000Z\r\n",
+		"UID:20231029-server-save@tibia-crawler\r\n" +
+			"DTSTAMP:20230705T123000Z\r\n" +
+			"DTSTART:20231029T090000Z\r\n" +
+			"DTEND:20231029T091000Z\r\n",
+	} {
+		if !strings.Contains(feed, want) {
+			t.Errorf("feed does not contain:\n%s", want)
+		}
+	}
+}
+
+func TestEncodeFolding(t *testing.T) {
+	desc := strings.Repeat("Ótimo evento para caçar em dobro. ", 10)
+
+	calendars := []tibia.EventCalendar{
+		{
+			Year:  2023,
+			Month: time.July,
+			Events: []tibia.Event{
+				event("Double XP", desc, day(time.July, 7)),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, calendars, Options{Stamp: stamp}); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > maxLineLength {
+			t.Errorf("line longer than %d octets: %q", maxLineLength, line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	if !strings.Contains(unfolded, "DESCRIPTION:"+desc+"\r\n") {
+		t.Errorf("Wrong unfolded description\ngot: %s", unfolded)
+	}
+}
+
+func TestExport(t *testing.T) {
+	files := map[string]string{
+		"6": "eventcalendar_june.html",
+		"7": "eventcalendar.html",
+	}
+
+	var months []string
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			month := r.URL.Query().Get("calendarmonth")
+			months = append(months, month)
+			file := files[month]
+			if r.URL.Query().Get("calendaryear") != "2023" || file == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(static.MustRead(t, file)))
+		},
+	))
+	defer srv.Close()
+
+	baseURL := parsers.BaseURL
+	parsers.BaseURL = srv.URL
+	defer func() { parsers.BaseURL = baseURL }()
+
+	var buf bytes.Buffer
+	err := Export(context.Background(), &buf, Args{
+		From:   day(time.July, 15),
+		Months: 1,
+	}, parsers.Options{})
+	if err != nil {
+		t.Fatalf("failed to export: %s", err)
+	}
+
+	// Rapid Respawn started on Jun 30, so June is fetched as well.
+	if want := []string{"7", "6"}; !reflect.DeepEqual(months, want) {
+		t.Errorf("Wrong requested months\nwant: %v\ngot: %v", want, months)
+	}
+
+	feed := buf.String()
+
+	for _, uid := range []string{
+		"UID:20230630-rapid-respawn-3d42d1fe@tibia-crawler\r\n",
+		"UID:20230707-double-xp-and-double-skill-738912df@tibia-crawler\r\n",
+		"UID:20230720-lightbearer-e50e18d6@tibia-crawler\r\n",
+		"UID:20230728-rapid-respawn-3d42d1fe@tibia-crawler\r\n",
+	} {
+		if !strings.Contains(feed, uid) {
+			t.Errorf("feed does not contain %q", uid)
+		}
+	}
+
+	// Chyllfroest is only displayed on the June calendar.
+	if strings.Contains(feed, "Chyllfroest") {
+		t.Errorf("feed contains an event of a month that was not exported")
+	}
+}
+
+func TestExportInvalidArgs(t *testing.T) {
+	for _, args := range []Args{
+		{},
+		{Months: 1},
+		{From: day(time.July, 1)},
+		{From: day(time.July, 1), Months: -1},
+	} {
+		err := Export(context.Background(), io.Discard, args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+// monthCalendar returns the calendar of month with the days of events that
+// tibia.com displays on it, from the Monday before the first day of the month
+// to 6 weeks later.
+func monthCalendar(
+	month time.Month,
+	events ...tibia.Event,
+) tibia.EventCalendar {
+	first := day(month, 1)
+	first = first.AddDate(0, 0, -(int(first.Weekday())+6)%7)
+	last := first.AddDate(0, 0, 6*7-1)
+
+	calendar := tibia.EventCalendar{Year: 2023, Month: month}
+	for _, e := range events {
+		var days []time.Time
+		for _, d := range e.Days {
+			if !d.Before(first) && !d.After(last) {
+				days = append(days, d)
+			}
+		}
+		if len(days) > 0 {
+			calendar.Events = append(
+				calendar.Events, event(e.Name, e.Description, days...),
+			)
+		}
+	}
+
+	return calendar
+}
+
+func dayRange(from, to time.Time) []time.Time {
+	var days []time.Time
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	return days
+}
+
+func TestExportOverlappingMonths(t *testing.T) {
+	events := []tibia.Event{
+		// Lasts long enough to be cut at the edge of the August calendar.
+		event(
+			"Lightbearer", "Light the basins.",
+			dayRange(day(time.July, 10), day(time.August, 20))...,
+		),
+		event(
+			"Rapid Respawn", "Creatures respawn faster.",
+			dayRange(day(time.August, 25), day(time.August, 27))...,
+		),
+	}
+
+	get := func(month time.Time) (tibia.EventCalendar, error) {
+		return monthCalendar(month.Month(), events...), nil
+	}
+
+	uids := func(from time.Month, months int) map[string]string {
+		var buf bytes.Buffer
+		err := export(&buf, Args{
+			From:    day(from, 1),
+			Months:  months,
+			Options: Options{Stamp: stamp},
+		}, get)
+		if err != nil {
+			t.Fatalf("failed to export: %s", err)
+		}
+
+		uids := make(map[string]string)
+		var uid string
+		for _, line := range strings.Split(buf.String(), "\r\n") {
+			if v, ok := strings.CutPrefix(line, "UID:"); ok {
+				uid = v
+			}
+			if name, ok := strings.CutPrefix(line, "SUMMARY:"); ok {
+				uids[name] = uid
+			}
+		}
+		return uids
+	}
+
+	julAug := uids(time.July, 2)
+	augSep := uids(time.August, 2)
+
+	for _, name := range []string{"Lightbearer", "Rapid Respawn"} {
+		if julAug[name] == "" || julAug[name] != augSep[name] {
+			t.Errorf(
+				"Wrong UID of %s\nJul-Aug: %s\nAug-Sep: %s",
+				name, julAug[name], augSep[name],
+			)
+		}
+	}
+
+	if !strings.HasPrefix(augSep["Lightbearer"], "20230710-") {
+		t.Errorf(
+			"Wrong first day of Lightbearer\ngot: %s", augSep["Lightbearer"],
+		)
+	}
+}
+
+func TestEncodeSameDayUIDs(t *testing.T) {
+	calendars := []tibia.EventCalendar{
+		{
+			Year:  2023,
+			Month: time.July,
+			Events: []tibia.Event{
+				event("Double XP", "Double experience.", day(time.July, 7)),
+				event("Double XP", "Double skill.", day(time.July, 7)),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, calendars, Options{Stamp: stamp}); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+
+	var uids []string
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if strings.HasPrefix(line, "UID:") {
+			uids = append(uids, line)
+		}
+	}
+
+	if len(uids) != 2 || uids[0] == uids[1] {
+		t.Errorf("UIDs are not unique: %v", uids)
+	}
+}
@@ -0,0 +1,113 @@
+// Package article provides helpers to read the news entries displayed by
+// tibia.com.
+//
+// News entries are displayed the same way on the news page, the Latest News
+// page and the News Archive, so the parsers of these pages share the helpers
+// of this package.
+package article
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// HeadlineIndexer marks the beginning of every news article.
+const HeadlineIndexer = `<div class="NewsHeadline">`
+
+const (
+	headlineDateClass = "NewsHeadlineDate"
+	headlineTextClass = "NewsHeadlineText"
+
+	iconIndexer    = "newsicon_"
+	endIconIndexer = "_"
+
+	idIndexer    = "id="
+	endIDIndexer = `"`
+
+	dateSuffix = " -"
+)
+
+// Read reads the article that starts at s, right after HeadlineIndexer.
+//
+// The Type of the returned article is always tibia.NewsTypeNews, and its ID
+// is only set if the title links to the News Archive.
+func Read(s string) (tibia.News, error) {
+	title := scrape.InnerByClass(s, "div", headlineTextClass)
+
+	article := tibia.News{
+		Type:  tibia.NewsTypeNews,
+		Title: scrape.Text(title),
+	}
+
+	var err error
+
+	article.Category, err = ReadCategory(s)
+	if err != nil {
+		return article, err
+	}
+
+	date := scrape.InnerByClass(s, "div", headlineDateClass)
+	article.Date, err = ReadDate(date)
+	if err != nil {
+		return article, err
+	}
+
+	if id, _, ok := scrape.Between(title, idIndexer, endIDIndexer); ok {
+		article.ID, err = scrape.Int(id)
+		if err != nil {
+			return article, fmt.Errorf("%s: id: %w", article.Title, err)
+		}
+	}
+
+	// The body is the first cell of the table that follows the headline, the
+	// other rows hold the link to the forum thread.
+	tables := scrape.Elements(s, "table")
+	if len(tables) == 0 {
+		return article, fmt.Errorf("%s: body not found", article.Title)
+	}
+
+	rows := scrape.Rows(tables[0])
+	if len(rows) == 0 {
+		return article, fmt.Errorf("%s: body not found", article.Title)
+	}
+
+	if cells := scrape.Cells(rows[0]); len(cells) > 0 {
+		article.Body = ReadBody(cells[0])
+	}
+
+	return article, nil
+}
+
+// ReadCategory reads the category from the icon of a news entry, such as
+// "newsicon_development_big.png" or "newsicon_community_small.gif".
+func ReadCategory(s string) (tibia.NewsCategory, error) {
+	category, _, ok := scrape.Between(s, iconIndexer, endIconIndexer)
+	if !ok {
+		return tibia.NewsCategory{}, fmt.Errorf("category not found")
+	}
+
+	c, err := tibia.NewsCategoryFromString(category)
+	if err != nil {
+		return c, fmt.Errorf("category: %w", err)
+	}
+	return c, nil
+}
+
+// ReadDate reads dates such as "Jul 04 2023 -".
+func ReadDate(s string) (time.Time, error) {
+	t, err := scrape.Date(strings.TrimSuffix(scrape.Text(s), dateSuffix))
+	if err != nil {
+		return t, fmt.Errorf("date: %w", err)
+	}
+	return t, nil
+}
+
+// ReadBody converts the HTML of a news entry into text, keeping paragraphs
+// apart.
+func ReadBody(s string) string {
+	return scrape.Text(strings.ReplaceAll(s, "</p>", "<br/>"))
+}
@@ -0,0 +1,227 @@
+// Package bazaar provides helpers to read the auctions displayed by the
+// tibia.com Char Bazaar.
+//
+// Every auction is displayed with the same layout, be it on the list of
+// current auctions, on the auction history or on the page of the auction
+// itself, so the parsers of those pages share the helpers of this package.
+package bazaar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// AuctionsPerPage is the amount of auctions displayed on each page of the
+// Char Bazaar lists.
+const AuctionsPerPage = 25
+
+// ErrNoResults is returned by ReadResults when the amount of results could
+// not be found.
+var ErrNoResults = errors.New("bazaar: results not found")
+
+const (
+	auctionIndexer     = `<div class="Auction"`
+	nameIndexer        = `<div class="AuctionCharacterName"`
+	bodyIndexer        = `<div class="AuctionBody"`
+	dataLabelIndexer   = `<div class="ShortAuctionDataLabel"`
+	auctionInfoIndexer = `<div class="AuctionInfo"`
+
+	idIndexer    = "auctionid="
+	endIDIndexer = "&"
+
+	resultsIndexer    = "Results: "
+	endResultsIndexer = "<"
+
+	fieldSeparator = " | "
+	labelSeparator = ": "
+
+	levelLabel    = "Level"
+	vocationLabel = "Vocation"
+	worldLabel    = "World"
+
+	startLabel = "Auction Start"
+	endLabel   = "Auction End"
+)
+
+// Card is an auction as displayed by the Char Bazaar.
+type Card struct {
+	// Overview is the information about the auction.
+	Overview tibia.AuctionOverview
+
+	// Info is the text displayed instead of the bid form once the auction
+	// ended, i.e. "finished".
+	//
+	// Info is empty if the auction is still running.
+	Info string
+}
+
+// ReadAuctions reads every auction found in s.
+func ReadAuctions(s string) ([]Card, error) {
+	parts := strings.Split(s, auctionIndexer)
+
+	cards := make([]Card, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		card, err := ReadAuction(part)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+
+	return cards, nil
+}
+
+// ReadAuction reads the first auction found in s.
+func ReadAuction(s string) (Card, error) {
+	var card Card
+
+	header, body, ok := strings.Cut(s, bodyIndexer)
+	if !ok {
+		return card, fmt.Errorf("bazaar: auction body not found")
+	}
+
+	if err := readHeader(&card.Overview, header); err != nil {
+		return card, fmt.Errorf("bazaar: %w", err)
+	}
+
+	if err := readData(&card, body); err != nil {
+		return card, fmt.Errorf("bazaar: %s: %w", card.Overview.Character, err)
+	}
+
+	if idx := strings.Index(body, auctionInfoIndexer); idx != -1 {
+		if info := scrape.Elements(body[idx:], "div"); len(info) > 0 {
+			card.Info = scrape.Text(info[0])
+		}
+	}
+
+	return card, nil
+}
+
+// readHeader reads the header of an auction, which holds the name of the
+// character and a line such as
+// "Level: 1174 | Vocation: Elite Knight | Male | World: Antica".
+func readHeader(auction *tibia.AuctionOverview, header string) error {
+	idx := strings.Index(header, nameIndexer)
+	if idx == -1 {
+		return fmt.Errorf("character name not found")
+	}
+	header = header[idx:]
+
+	id, _, ok := scrape.Between(header, idIndexer, endIDIndexer)
+	if !ok {
+		return fmt.Errorf("auction id not found")
+	}
+
+	var err error
+
+	auction.ID, err = scrape.Int(id)
+	if err != nil {
+		return fmt.Errorf("auction id: %w", err)
+	}
+
+	divs := scrape.Elements(header, "div")
+	if len(divs) == 0 {
+		return fmt.Errorf("character name not found")
+	}
+	name := divs[0]
+	auction.Character = scrape.Text(name)
+
+	line := scrape.Text(strings.TrimPrefix(header, name))
+	for _, field := range strings.Split(line, fieldSeparator) {
+		label, val, ok := strings.Cut(field, labelSeparator)
+		if !ok {
+			// Fields without a label, such as the sex of the character.
+			continue
+		}
+
+		switch label {
+		case levelLabel:
+			auction.Level, err = scrape.Int(val)
+			if err != nil {
+				return fmt.Errorf("%s level: %w", auction.Character, err)
+			}
+		case vocationLabel:
+			auction.Vocation, err = tibia.VocationFromString(val)
+			if err != nil {
+				return fmt.Errorf("%s vocation: %w", auction.Character, err)
+			}
+		case worldLabel:
+			auction.World = val
+		}
+	}
+
+	return nil
+}
+
+// readData reads the short auction data, which is made of label and value
+// pairs such as "Auction End:" and "Jul 15 2023, 10:00 CEST". The last pair
+// is the bid, whose label depends on the state of the auction.
+func readData(card *Card, body string) error {
+	var found bool
+
+	for {
+		idx := strings.Index(body, dataLabelIndexer)
+		if idx == -1 {
+			break
+		}
+		body = body[idx:]
+
+		divs := scrape.Elements(body, "div")
+		if len(divs) < 2 {
+			return fmt.Errorf("auction data value not found")
+		}
+		body = body[len(divs[0]):]
+
+		label := strings.TrimSuffix(scrape.Text(divs[0]), ":")
+		val := divs[1]
+
+		var err error
+
+		switch label {
+		case startLabel:
+			card.Overview.Start, err = scrape.DateTime(val)
+		case endLabel:
+			card.Overview.End, err = scrape.DateTime(val)
+		default:
+			found = true
+			card.Overview.BidStatus, err = tibia.AuctionBidStatusFromString(
+				label,
+			)
+			if err != nil {
+				break
+			}
+			card.Overview.Bid, err = scrape.Int(val)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", label, err)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("bid not found")
+	}
+
+	return nil
+}
+
+// ReadResults reads the amount of auctions of a list, across all pages, and
+// returns it along with the amount of pages.
+func ReadResults(content string) (results, pages int, err error) {
+	s, _, ok := scrape.Between(content, resultsIndexer, endResultsIndexer)
+	if !ok {
+		return 0, 0, ErrNoResults
+	}
+
+	results, err = scrape.Int(s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("bazaar: results: %w", err)
+	}
+
+	pages = (results + AuctionsPerPage - 1) / AuctionsPerPage
+
+	return results, pages, nil
+}
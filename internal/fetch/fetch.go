@@ -0,0 +1,167 @@
+// Package fetch provides the HTTP plumbing shared by the parsers.
+//
+// Every function of this package honors the parsers.Options passed to it,
+// meaning it waits on the RateLimiter before each request, retries up to
+// Retries times and uses the given HTTPClient, falling back to
+// http.DefaultClient.
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+)
+
+// Get makes a GET request to rawURL and returns the response body.
+//
+// The body is always returned as UTF-8. ISO-8859-1 bodies, the charset used
+// by most tibia.com pages, are converted.
+//
+// sizeHint is the aprox Content-Length of the data returned by the endpoint
+// and it is used to preallocate the buffer the body is read into.
+func Get(
+	ctx context.Context,
+	rawURL string,
+	opts parsers.Options,
+	sizeHint int,
+) (string, error) {
+	return do(ctx, http.MethodGet, rawURL, nil, opts, sizeHint)
+}
+
+// PostForm makes a POST request to rawURL with form url encoded as the
+// request body and returns the response body.
+//
+// Like Get, the body is always returned as UTF-8.
+//
+// sizeHint is the aprox Content-Length of the data returned by the endpoint
+// and it is used to preallocate the buffer the body is read into.
+func PostForm(
+	ctx context.Context,
+	rawURL string,
+	form url.Values,
+	opts parsers.Options,
+	sizeHint int,
+) (string, error) {
+	return do(ctx, http.MethodPost, rawURL, form, opts, sizeHint)
+}
+
+func do(
+	ctx context.Context,
+	method, rawURL string,
+	form url.Values,
+	opts parsers.Options,
+	sizeHint int,
+) (string, error) {
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+
+	if opts.Retries == 0 {
+		opts.Retries = 1
+	}
+
+	var (
+		data string
+		err  error
+	)
+	for i := 0; i < int(opts.Retries); i++ {
+		data, err = makeRequest(ctx, method, rawURL, form, opts, sizeHint)
+		if err == nil || errors.Is(err, parsers.ErrCtxDone) {
+			break
+		}
+	}
+
+	return data, err
+}
+
+func makeRequest(
+	ctx context.Context,
+	method, rawURL string,
+	form url.Values,
+	opts parsers.Options,
+	sizeHint int,
+) (string, error) {
+	select {
+	case <-ctx.Done():
+		return "", parsers.ErrCtxDone
+	default:
+	}
+
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return "", fmt.Errorf("fetch: failed to create req: %w", err)
+	}
+
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	if opts.RateLimiter != nil {
+		opts.RateLimiter.Take()
+	}
+
+	res, err := opts.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetch: failed to make req: %w", err)
+	}
+	defer res.Body.Close()
+	defer discard(res.Body)
+
+	if res.Request != nil && res.Request.URL != nil &&
+		res.Request.URL.Host == parsers.MaintenanceHost {
+		return "", parsers.ErrMaintenance
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		// continue
+	case http.StatusForbidden:
+		return "", fmt.Errorf(
+			"fetch: request forbidden by cip: %w",
+			parsers.ErrRateLimited,
+		)
+	case http.StatusFound:
+		loc, err := res.Location()
+		if err != nil {
+			return "", fmt.Errorf(
+				"fetch: failed to get location from response",
+			)
+		}
+
+		if loc.Host == parsers.MaintenanceHost {
+			return "", parsers.ErrMaintenance
+		}
+
+		fallthrough
+	default:
+		return "", fmt.Errorf(
+			"fetch: code %d: %w",
+			res.StatusCode, parsers.ErrUnknownStatusCode,
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(sizeHint)
+	if _, err := io.Copy(&buf, res.Body); err != nil {
+		return "", fmt.Errorf("fetch: failed to read body: %w", err)
+	}
+
+	return scrape.UTF8(buf.String()), nil
+}
+
+func discard(src io.Reader) {
+	_, _ = io.Copy(io.Discard, src)
+}
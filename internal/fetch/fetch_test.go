@@ -0,0 +1,129 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/parsers"
+)
+
+func TestGet(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			switch r.URL.Path {
+			case "/ok":
+				_, _ = w.Write([]byte("Torbj\xf6rn"))
+			case "/forbidden":
+				w.WriteHeader(http.StatusForbidden)
+			case "/maintenance":
+				w.Header().Set("Location", "https://"+parsers.MaintenanceHost)
+				w.WriteHeader(http.StatusFound)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		},
+	))
+	defer srv.Close()
+
+	opts := parsers.Options{
+		HTTPClient: &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		Retries: 3,
+	}
+
+	for _, tc := range []struct {
+		name  string
+		path  string
+		want  string
+		err   error
+		calls int
+	}{
+		{
+			name:  "ok",
+			path:  "/ok",
+			want:  "Torbjörn",
+			calls: 1,
+		},
+		{
+			name:  "forbidden",
+			path:  "/forbidden",
+			err:   parsers.ErrRateLimited,
+			calls: 3,
+		},
+		{
+			name:  "maintenance",
+			path:  "/maintenance",
+			err:   parsers.ErrMaintenance,
+			calls: 3,
+		},
+		{
+			name:  "unknown status code",
+			path:  "/error",
+			err:   parsers.ErrUnknownStatusCode,
+			calls: 3,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = 0
+
+			data, err := Get(context.Background(), srv.URL+tc.path, opts, 0)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("unexpected error\nwant: %v\ngot: %v", tc.err, err)
+			}
+
+			if data != tc.want {
+				t.Errorf("unexpected data\nwant: %q\ngot: %q", tc.want, data)
+			}
+
+			if calls != tc.calls {
+				t.Errorf("unexpected calls\nwant: %d\ngot: %d", tc.calls, calls)
+			}
+		})
+	}
+}
+
+func TestPostForm(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			_, _ = w.Write([]byte(r.PostFormValue("name")))
+		},
+	))
+	defer srv.Close()
+
+	form := url.Values{}
+	form.Set("name", "Kharsek Valdor")
+
+	data, err := PostForm(
+		context.Background(), srv.URL, form, parsers.Options{}, 0,
+	)
+	if err != nil {
+		t.Fatalf("failed to post form: %s", err)
+	}
+
+	if data != "Kharsek Valdor" {
+		t.Errorf("unexpected data\nwant: %q\ngot: %q", "Kharsek Valdor", data)
+	}
+}
+
+func TestCtxDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Get(ctx, "http://localhost", parsers.Options{Retries: 3}, 0)
+	if !errors.Is(err, parsers.ErrCtxDone) {
+		t.Errorf("unexpected error\nwant: %v\ngot: %v", parsers.ErrCtxDone, err)
+	}
+}
@@ -0,0 +1,135 @@
+// Package pager provides the iterator shared by the parsers of paginated
+// lists, such as the highscores and the auction history.
+package pager
+
+import "fmt"
+
+// PageError is returned by Iterator when a page could not be fetched or
+// parsed.
+//
+// The crawl can be resumed from the failed page by creating a new Iterator
+// that starts at Page.
+type PageError struct {
+	// Page is the page that failed.
+	Page int
+
+	// Err is the error returned while parsing the page.
+	Err error
+
+	// name is the name of the list the page belongs to, used as the prefix
+	// of the error message.
+	name string
+}
+
+// Error implements the error interface.
+func (e *PageError) Error() string {
+	if e.name == "" {
+		return fmt.Sprintf("page %d: %s", e.Page, e.Err)
+	}
+	return fmt.Sprintf("%s: page %d: %s", e.name, e.Page, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PageError) Unwrap() error {
+	return e.Err
+}
+
+// FetchFunc fetches the items of page. last reports whether page is the last
+// page of the list.
+type FetchFunc[T any] func(page int) (items []T, last bool, err error)
+
+// Iterator walks all the pages of a list, from the first page it was created
+// with until the last page is reached.
+//
+// Pages are only fetched once all the items of the previous page were
+// consumed. An empty page also ends the list, even if the list reported more
+// pages than it actually displays.
+type Iterator[T any] struct {
+	name  string
+	fetch FetchFunc[T]
+
+	items []T
+	idx   int
+	page  int
+	next  int
+	done  bool
+	err   error
+}
+
+// New returns an Iterator that starts at page and uses fetch to get the items
+// of each page. If page is 0, the crawl starts at the first page.
+//
+// name prefixes the message of the errors returned by Err.
+func New[T any](name string, page int, fetch FetchFunc[T]) *Iterator[T] {
+	if page == 0 {
+		page = 1
+	}
+
+	return &Iterator[T]{
+		name:  name,
+		fetch: fetch,
+		next:  page,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or an error
+// happened, in which case Err reports it.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.idx++
+	for it.idx >= len(it.items) {
+		if it.done {
+			return false
+		}
+
+		if err := it.fetchNext(); err != nil {
+			it.err = &PageError{Page: it.next, Err: err, name: it.name}
+			return false
+		}
+	}
+
+	return true
+}
+
+func (it *Iterator[T]) fetchNext() error {
+	items, last, err := it.fetch(it.next)
+	if err != nil {
+		return err
+	}
+
+	it.items = items
+	it.idx = 0
+	it.page = it.next
+
+	if len(items) == 0 || last {
+		it.done = true
+		return nil
+	}
+
+	it.next++
+	return nil
+}
+
+// Item returns the current item.
+//
+// Item must only be called after a call to Next returned true.
+func (it *Iterator[T]) Item() T {
+	return it.items[it.idx]
+}
+
+// Page returns the page the current item is from.
+func (it *Iterator[T]) Page() int {
+	return it.page
+}
+
+// Err returns the error that stopped the iterator, if any.
+//
+// Errors returned while fetching or parsing a page are wrapped in a
+// *PageError.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
@@ -0,0 +1,83 @@
+package pager
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	pages := map[int][]int{
+		1: {1, 2},
+		2: {3},
+		3: {},
+		4: {4},
+	}
+
+	var fetched []int
+	it := New("test", 0, func(page int) ([]int, bool, error) {
+		fetched = append(fetched, page)
+		return pages[page], page >= 4, nil
+	})
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Item())
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The empty page ends the list, even though it is not the last one.
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("Wrong items\nwant: %v\ngot: %v", []int{1, 2, 3}, got)
+	}
+
+	if len(fetched) != 3 {
+		t.Errorf(
+			"Wrong fetched pages\nwant: %v\ngot: %v", []int{1, 2, 3}, fetched,
+		)
+	}
+
+	if it.Page() != 3 {
+		t.Errorf("Wrong page\nwant: %d\ngot: %d", 3, it.Page())
+	}
+}
+
+func TestIteratorPageError(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+
+	it := New("test", 5, func(page int) ([]int, bool, error) {
+		if page == 6 {
+			return nil, false, errFetch
+		}
+		return []int{page}, false, nil
+	})
+
+	var n int
+	for it.Next() {
+		n++
+	}
+
+	if n != 1 {
+		t.Errorf("Wrong amount of items\nwant: %d\ngot: %d", 1, n)
+	}
+
+	var pageErr *PageError
+	if !errors.As(it.Err(), &pageErr) {
+		t.Fatalf("unexpected error\nwant: *PageError\ngot: %v", it.Err())
+	}
+
+	if pageErr.Page != 6 {
+		t.Errorf("Wrong page\nwant: %d\ngot: %d", 6, pageErr.Page)
+	}
+
+	if !errors.Is(it.Err(), errFetch) {
+		t.Errorf("unexpected error\nwant: %s\ngot: %v", errFetch, it.Err())
+	}
+
+	want := "test: page 6: fetch failed"
+	if got := it.Err().Error(); got != want {
+		t.Errorf("Wrong error\nwant: %s\ngot: %s", want, got)
+	}
+}
@@ -0,0 +1,429 @@
+// Package scrape provides helpers to extract data from the HTML served by
+// tibia.com.
+//
+// tibia.com pages are mostly made of tables, so the helpers of this package
+// are focused on locating tables by their caption and on splitting them into
+// rows and cells. They are not a general purpose HTML parser.
+package scrape
+
+import (
+	"errors"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// ContentStart marks the beginning of the main content of tibia.com
+	// pages.
+	ContentStart = `<div class="main-content Content">`
+
+	// ContentEnd marks the end of the main content of tibia.com pages.
+	ContentEnd = `<div id="Footer" class="main-footer">`
+)
+
+var (
+	// ErrNoContent is returned by Content when the main content of a
+	// tibia.com page could not be found.
+	ErrNoContent = errors.New("scrape: main content not found")
+
+	// ErrInvalidInt is returned by Int when the given string is not a number.
+	ErrInvalidInt = errors.New("scrape: invalid int")
+
+	// ErrInvalidTime is returned by DateTime and Date when the given string is
+	// not a valid tibia.com timestamp.
+	ErrInvalidTime = errors.New("scrape: invalid time")
+)
+
+// Content returns the main content of a tibia.com page, that is, everything
+// between ContentStart and ContentEnd.
+//
+// The returned content is always UTF-8, see UTF8. Data returned by the fetch
+// package is already UTF-8, but raw pages, such as the ones in testdata, are
+// usually ISO-8859-1 encoded.
+func Content(data string) (string, error) {
+	startIdx := strings.Index(data, ContentStart)
+	if startIdx == -1 {
+		return "", ErrNoContent
+	}
+
+	data = data[startIdx+len(ContentStart):]
+
+	endIdx := strings.Index(data, ContentEnd)
+	if endIdx == -1 {
+		return "", ErrNoContent
+	}
+
+	return UTF8(data[:endIdx]), nil
+}
+
+// UTF8 converts ISO-8859-1 encoded data, the charset used by most tibia.com
+// pages, into UTF-8.
+//
+// If data is already valid UTF-8, it is returned as is.
+func UTF8(data string) string {
+	if utf8.ValidString(data) {
+		return data
+	}
+
+	var b strings.Builder
+	b.Grow(len(data) + len(data)/8)
+	for i := 0; i < len(data); i++ {
+		b.WriteRune(rune(data[i]))
+	}
+	return b.String()
+}
+
+// Between returns the text of s between the first occurrence of start and the
+// first occurrence of end after it, along with what comes after end.
+//
+// ok is false if either start or end could not be found.
+func Between(s, start, end string) (inner, rest string, ok bool) {
+	startIdx := strings.Index(s, start)
+	if startIdx == -1 {
+		return "", s, false
+	}
+
+	s = s[startIdx+len(start):]
+
+	endIdx := strings.Index(s, end)
+	if endIdx == -1 {
+		return "", s, false
+	}
+
+	return s[:endIdx], s[endIdx+len(end):], true
+}
+
+// Table returns the inner HTML of the first content table that follows the
+// caption with the given text.
+//
+// tibia.com tables are rendered as a TableContainer with a caption, and the
+// actual rows live inside a nested table. Table skips the wrapping and returns
+// the inner HTML of the first table with the TableContent class, or the first
+// table at all if none has that class, after the caption.
+func Table(data, caption string) (string, bool) {
+	idx := strings.Index(data, `<div class="Text">`+caption+`</div>`)
+	if idx == -1 {
+		idx = strings.Index(data, `<div class="Text" >`+caption+`</div>`)
+	}
+	if idx == -1 {
+		return "", false
+	}
+
+	data = data[idx:]
+
+	// The caption belongs to the table container that wraps it. Limit the
+	// search to it, so the content table of a different container is never
+	// returned.
+	if end := strings.Index(data[1:], `<div class="TableContainer"`); end != -1 {
+		data = data[:end+1]
+	}
+
+	tables := Elements(data, "table")
+	for _, t := range tables {
+		if inner := contentTable(t); inner != "" {
+			return inner, true
+		}
+	}
+
+	if len(tables) == 0 {
+		return "", false
+	}
+
+	return tables[0], true
+}
+
+func contentTable(table string) string {
+	for _, t := range Elements(table, "table") {
+		if strings.HasPrefix(t, `<table class="TableContent"`) {
+			return t
+		}
+
+		if inner := contentTable(Inner(t)); inner != "" {
+			return inner
+		}
+	}
+	return ""
+}
+
+// Elements returns the outer HTML of every top level element with the given
+// tag name found in s. Nested elements with the same tag name are kept inside
+// their parent.
+func Elements(s, tag string) []string {
+	var (
+		open  = "<" + tag
+		close = "</" + tag + ">"
+		elems []string
+	)
+
+	for {
+		startIdx := indexTag(s, open)
+		if startIdx == -1 {
+			return elems
+		}
+
+		s = s[startIdx:]
+
+		depth, i := 0, 0
+		for {
+			nextOpen := indexTag(s[i:], open)
+			nextClose := strings.Index(s[i:], close)
+
+			if nextClose == -1 {
+				// Unclosed element, take everything that is left.
+				return append(elems, s)
+			}
+
+			if nextOpen != -1 && nextOpen < nextClose {
+				depth++
+				i += nextOpen + len(open)
+				continue
+			}
+
+			depth--
+			i += nextClose + len(close)
+			if depth == 0 {
+				break
+			}
+		}
+
+		elems = append(elems, s[:i])
+		s = s[i:]
+	}
+}
+
+// indexTag is like strings.Index but it makes sure the match is an actual tag
+// and not a prefix of a different one, i.e. "<t" matching "<tr".
+func indexTag(s, open string) int {
+	offset := 0
+	for {
+		idx := strings.Index(s[offset:], open)
+		if idx == -1 {
+			return -1
+		}
+
+		idx += offset
+		next := idx + len(open)
+		if next >= len(s) {
+			return -1
+		}
+
+		switch s[next] {
+		case ' ', '>', '/', '\n', '\t', '\r':
+			return idx
+		}
+
+		offset = next
+	}
+}
+
+// Inner returns the inner HTML of an element returned by Elements.
+func Inner(elem string) string {
+	start := strings.IndexByte(elem, '>')
+	if start == -1 {
+		return ""
+	}
+
+	end := strings.LastIndex(elem, "</")
+	if end == -1 || end < start {
+		return elem[start+1:]
+	}
+
+	return elem[start+1 : end]
+}
+
+// InnerByClass returns the inner HTML of the first element of s with the
+// given tag name and class, or an empty string if there is no such element.
+func InnerByClass(s, tag, class string) string {
+	idx := strings.Index(s, `class="`+class+`"`)
+	if idx == -1 {
+		return ""
+	}
+
+	start := strings.LastIndex(s[:idx], "<"+tag)
+	if start == -1 {
+		return ""
+	}
+
+	elems := Elements(s[start:], tag)
+	if len(elems) == 0 {
+		return ""
+	}
+
+	return Inner(elems[0])
+}
+
+// Rows returns the inner HTML of every row of the given table.
+func Rows(table string) []string {
+	if strings.HasPrefix(table, "<table") {
+		table = Inner(table)
+	}
+
+	rows := Elements(table, "tr")
+	for i, row := range rows {
+		rows[i] = Inner(row)
+	}
+	return rows
+}
+
+// Cells returns the inner HTML of every cell of the given row.
+func Cells(row string) []string {
+	cells := Elements(row, "td")
+	for i, cell := range cells {
+		cells[i] = Inner(cell)
+	}
+	return cells
+}
+
+// LabeledRows returns a map from the label to the value of a table whose
+// rows are made of a label cell, i.e. "Name:", and a value cell.
+//
+// The trailing colon is stripped from the labels, and the values are kept as
+// HTML.
+func LabeledRows(table string) map[string]string {
+	rows := Rows(table)
+	m := make(map[string]string, len(rows))
+	for _, row := range rows {
+		cells := Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		label := strings.TrimSuffix(Text(cells[0]), ":")
+		if label == "" {
+			continue
+		}
+
+		m[label] = cells[1]
+	}
+	return m
+}
+
+// Attr returns the value of the attribute name of the first tag found in s.
+func Attr(s, name string) (string, bool) {
+	end := strings.IndexByte(s, '>')
+	if end == -1 {
+		end = len(s)
+	}
+
+	tag := s[:end]
+	for _, quote := range []string{`"`, `'`} {
+		prefix := " " + name + "=" + quote
+		idx := strings.Index(tag, prefix)
+		if idx == -1 {
+			continue
+		}
+
+		val := tag[idx+len(prefix):]
+		valEnd := strings.Index(val, quote)
+		if valEnd == -1 {
+			return "", false
+		}
+
+		return html.UnescapeString(val[:valEnd]), true
+	}
+
+	return "", false
+}
+
+// Text returns the text content of the given HTML.
+//
+// Tags are stripped, line breaks are converted into new lines, entities are
+// unescaped, non breaking spaces are converted into regular spaces and runs
+// of white spaces are collapsed.
+func Text(s string) string {
+	s = strings.NewReplacer("\r", " ", "\n", " ", "\t", " ").Replace(s)
+	for _, br := range []string{"<br/>", "<br />", "<br>", "<BR>"} {
+		s = strings.ReplaceAll(s, br, "\n")
+	}
+
+	var (
+		b     strings.Builder
+		inTag bool
+	)
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+
+	s = html.UnescapeString(b.String())
+	s = strings.ReplaceAll(s, "\u00a0", " ")
+
+	lines := strings.Split(s, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			continue
+		}
+		kept = append(kept, line)
+	}
+
+	return strings.Join(kept, "\n")
+}
+
+// Int parses a number as displayed by tibia.com, i.e. "1,234,567".
+func Int(s string) (int, error) {
+	s = strings.NewReplacer(",", "", ".", "", " ", "").Replace(Text(s))
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, ErrInvalidInt
+	}
+	return n, nil
+}
+
+const (
+	dateTimeLayout      = "Jan 02 2006, 15:04:05"
+	shortDateTimeLayout = "Jan 02 2006, 15:04"
+	dateLayout          = "Jan 02 2006"
+)
+
+var (
+	cet  = time.FixedZone("CET", 1*60*60)
+	cest = time.FixedZone("CEST", 2*60*60)
+)
+
+// DateTime parses a timestamp as displayed by tibia.com, i.e.
+// "Jul 05 2023, 14:33:12 CEST". Timestamps without seconds, such as the ones
+// of the Char Bazaar, are parsed as well.
+func DateTime(s string) (time.Time, error) {
+	s = Text(s)
+
+	loc := cet
+	switch {
+	case strings.HasSuffix(s, " CEST"):
+		loc = cest
+		s = strings.TrimSuffix(s, " CEST")
+	case strings.HasSuffix(s, " CET"):
+		s = strings.TrimSuffix(s, " CET")
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, s, loc)
+	if err != nil {
+		t, err = time.ParseInLocation(shortDateTimeLayout, s, loc)
+	}
+	if err != nil {
+		return time.Time{}, ErrInvalidTime
+	}
+	return t, nil
+}
+
+// Date parses a date as displayed by tibia.com, i.e. "Jul 05 2023".
+//
+// The returned time is at midnight UTC.
+func Date(s string) (time.Time, error) {
+	t, err := time.Parse(dateLayout, Text(s))
+	if err != nil {
+		return time.Time{}, ErrInvalidTime
+	}
+	return t, nil
+}
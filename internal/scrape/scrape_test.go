@@ -0,0 +1,118 @@
+package scrape
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestText(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "plain",
+			input: "Antica",
+			want:  "Antica",
+		},
+		{
+			name:  "tags",
+			input: `<a href="x" ><b>Red</b>&#160;Rose</a>`,
+			want:  "Red Rose",
+		},
+		{
+			name:  "line breaks",
+			input: "first<br />  second\n third<br/>",
+			want:  "first\nsecond third",
+		},
+		{
+			name:  "entities",
+			input: "&quot;Bubble&quot; &amp; friends",
+			want:  `"Bubble" & friends`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Text(tc.input); got != tc.want {
+				t.Errorf("unexpected text\nwant: %q\ngot: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRowsAndCells(t *testing.T) {
+	table := `<table class="TableContent"><tr class="Odd"><td>a</td>` +
+		`<td><table><tr><td>nested</td></tr></table></td></tr>` +
+		`<tr><td class="x" >b</td><td>c</td></tr></table>`
+
+	rows := Rows(table)
+	if len(rows) != 2 {
+		t.Fatalf("unexpected rows\nwant: %d\ngot: %d", 2, len(rows))
+	}
+
+	want := []string{"a", `<table><tr><td>nested</td></tr></table>`}
+	if got := Cells(rows[0]); !reflect.DeepEqual(want, got) {
+		t.Errorf("unexpected cells\nwant: %q\ngot: %q", want, got)
+	}
+
+	want = []string{"b", "c"}
+	if got := Cells(rows[1]); !reflect.DeepEqual(want, got) {
+		t.Errorf("unexpected cells\nwant: %q\ngot: %q", want, got)
+	}
+}
+
+func TestInt(t *testing.T) {
+	for input, want := range map[string]int{
+		"1":          1,
+		"1,234":      1234,
+		"1.234.567":  1234567,
+		"<b>42</b>":  42,
+		"&#160;7 ":   7,
+		"-1,000,000": -1000000,
+	} {
+		got, err := Int(input)
+		if err != nil {
+			t.Errorf("failed to parse %q: %s", input, err)
+			continue
+		}
+
+		if got != want {
+			t.Errorf("unexpected int\nwant: %d\ngot: %d", want, got)
+		}
+	}
+
+	if _, err := Int("abc"); err != ErrInvalidInt {
+		t.Errorf("unexpected error\nwant: %v\ngot: %v", ErrInvalidInt, err)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		want  time.Time
+	}{
+		{
+			input: "Jul&#160;05&#160;2023,&#160;14:33:12&#160;CEST",
+			want:  time.Date(2023, time.July, 5, 12, 33, 12, 0, time.UTC),
+		},
+		{
+			input: "Jan 02 2006, 19:42:55 CET",
+			want:  time.Date(2006, time.January, 2, 18, 42, 55, 0, time.UTC),
+		},
+		{
+			input: "Jul 15 2023, 10:00 CEST",
+			want:  time.Date(2023, time.July, 15, 8, 0, 0, 0, time.UTC),
+		},
+	} {
+		got, err := DateTime(tc.input)
+		if err != nil {
+			t.Errorf("failed to parse %q: %s", tc.input, err)
+			continue
+		}
+
+		if !got.Equal(tc.want) {
+			t.Errorf("unexpected time\nwant: %s\ngot: %s", tc.want, got)
+		}
+	}
+}
@@ -1,8 +1,33 @@
 // Package static provides embeded files.
 package static
 
-import "embed"
+import (
+	"embed"
+	"io"
+	"testing"
+)
 
 // TestData embeds all test data.
+//
 //go:embed testdata/*
 var TestData embed.FS
+
+// MustRead returns the contents of the file name of the testdata directory.
+//
+// If the file can not be read, t fails immediately.
+func MustRead(t testing.TB, name string) string {
+	t.Helper()
+
+	f, err := TestData.Open("testdata/" + name)
+	if err != nil {
+		t.Fatalf("failed to open test data: %s\n%#v\n", err, err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,125 @@
+// Package achievements provides an implementation of the Parser interface for
+// parsing the list of achievements from the tibia.com Achievements Library
+// page.
+//
+// To use the achievements package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called to fetch the HTML content from the
+// Achievements Library page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// To find the achievements a character has not displayed, see
+// tibia.MissingAchievements.
+package achievements
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/library/?subtopic=achievements"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the achievements endpoint.
+	contentLength = 300000
+)
+
+var _ parsers.Parser[Args, []tibia.Achievement] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the list of achievements from the tibia.com Achievements Library
+// page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface, but it is
+// not used by this implementation.
+type Args struct{}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) ([]tibia.Achievement, error) {
+	data, err := fetch.Get(ctx, p.URL(), opts, contentLength)
+	if err != nil {
+		return nil, fmt.Errorf("achievements: %w", err)
+	}
+
+	achievements, err := p.parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("achievements: failed to parse body: %w", err)
+	}
+
+	return achievements, nil
+}
+
+const (
+	achievementsCaption = "Achievements"
+
+	gradeIndexer  = "achievement-grade-symbol"
+	secretIndexer = "achievement-secret-symbol"
+
+	yesVal = "yes"
+)
+
+func (p *Parser) parse(data string) ([]tibia.Achievement, error) {
+	content, err := scrape.Content(data)
+	if err != nil {
+		return nil, fmt.Errorf("achievements: %w", err)
+	}
+
+	table, ok := scrape.Table(content, achievementsCaption)
+	if !ok {
+		return nil, fmt.Errorf("achievements: %w", parsers.ErrNotFound)
+	}
+
+	var achievements []tibia.Achievement
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 5 {
+			continue
+		}
+
+		// The grade is displayed as one symbol per grade, which also skips
+		// the header row.
+		grade := strings.Count(cells[0], gradeIndexer)
+		if grade == 0 {
+			continue
+		}
+
+		achievement := tibia.Achievement{
+			Name:        scrape.Text(cells[2]),
+			Grade:       grade,
+			Description: scrape.Text(cells[3]),
+			Secret:      strings.Contains(cells[2], secretIndexer),
+			Premium:     scrape.Text(cells[4]) == yesVal,
+		}
+
+		achievement.Points, err = scrape.Int(cells[1])
+		if err != nil {
+			return nil, fmt.Errorf(
+				"achievements: %s points: %w", achievement.Name, err,
+			)
+		}
+
+		achievements = append(achievements, achievement)
+	}
+
+	return achievements, nil
+}
@@ -0,0 +1,78 @@
+package achievements
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	achievements, err := p.parse(static.MustRead(t, "achievements.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := []tibia.Achievement{
+		{
+			Name:   "Allow Cookies?",
+			Grade:  1,
+			Points: 1,
+			Description: "With a perfectly harmless smile you fooled all " +
+				"of those wicecrackers into eating your exploding cookies.",
+			Secret: true,
+		},
+		{
+			Name:   "Bone Brother",
+			Grade:  1,
+			Points: 1,
+			Description: "You've joined the undead bone brothers - making " +
+				"death your enemy and your weapon as well.",
+			Premium: true,
+		},
+		{
+			Name:   "Master of the Nexus",
+			Grade:  2,
+			Points: 6,
+			Description: "You were able to fight your way through the " +
+				"countless hordes in the Demon Forge.",
+			Secret:  true,
+			Premium: true,
+		},
+		{
+			Name:   "Ultimate Warlord",
+			Grade:  3,
+			Points: 8,
+			Description: "You have mastered the Arena of Svargrond with " +
+				"distinction.",
+			Premium: true,
+		},
+		{
+			Name:   "Wayfarer",
+			Grade:  1,
+			Points: 2,
+			Description: "Dragging a heavy backpack around the world is " +
+				"what you like best.",
+		},
+	}
+
+	if !reflect.DeepEqual(achievements, want) {
+		t.Errorf("Wrong achievements\nwant: %+v\ngot: %+v", want, achievements)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "spells.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
@@ -0,0 +1,219 @@
+// Package auction provides an implementation of the Parser interface for
+// parsing information about a single auction from the tibia.com Char Bazaar.
+//
+// To use the auction package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the ID of the auction to fetch the
+// HTML content from the Char Bazaar page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// The items, the mounts and the outfits of an auction are paginated, and only
+// their first pages are part of the auction page. Parse fetches the remaining
+// pages transparently, so a single call may make several requests to
+// tibia.com, all of them honoring parsers.Options.
+//
+// The IDs of the auctions can be found with the auctions package.
+package auction
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/bazaar"
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/charactertrade/?subtopic=currentcharactertrades"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the auction endpoint.
+	contentLength = 300000
+)
+
+var _ parsers.Parser[Args, tibia.Auction] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing information about a single auction from the tibia.com Char Bazaar.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// ID is the identifier tibia.com uses for the auction.
+	//
+	// ID must be greater than 0. Otherwise, parsers.ErrInvalidArgs is
+	// returned.
+	ID int
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the auction, which happens when the ID does
+// not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Auction, error) {
+	if args.ID <= 0 {
+		return tibia.Auction{}, fmt.Errorf(
+			"auction: invalid id %d: %w", args.ID, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.auctionURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Auction{}, fmt.Errorf("auction: %w", err)
+	}
+
+	auction, pending, err := p.parse(data)
+	if err != nil {
+		return tibia.Auction{}, fmt.Errorf(
+			"auction: failed to parse body: %w", err,
+		)
+	}
+
+	for _, pages := range pending {
+		if err := p.fetchPages(ctx, &auction, pages, opts); err != nil {
+			return tibia.Auction{}, fmt.Errorf("auction: %w", err)
+		}
+	}
+
+	return auction, nil
+}
+
+func (p *Parser) auctionURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("page", "details")
+	vals.Set("auctionid", strconv.Itoa(args.ID))
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	auctionIndexer = `<div class="Auction"`
+
+	generalCaption    = "General"
+	blessingsCaption  = "Blessings"
+	imbuementsCaption = "Imbuements"
+	charmsCaption     = "Charms"
+	questLinesCaption = "Completed Quest Lines"
+	achievsCaption    = "Achievements"
+	bestiaryCaption   = "Bestiary Progress"
+	bosstiaryCaption  = "Bosstiary Progress"
+)
+
+// parse parses the auction page and returns the auction along with the
+// paginated blocks that have pages left to be fetched.
+func (p *Parser) parse(data string) (tibia.Auction, []blockPages, error) {
+	var auction tibia.Auction
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return auction, nil, fmt.Errorf("auction: %w", err)
+	}
+
+	idx := strings.Index(content, auctionIndexer)
+	if idx == -1 {
+		return auction, nil, fmt.Errorf("auction: %w", parsers.ErrNotFound)
+	}
+
+	card, err := bazaar.ReadAuction(content[idx:])
+	if err != nil {
+		return auction, nil, fmt.Errorf("auction: %w", err)
+	}
+	auction.AuctionOverview = card.Overview
+
+	general, ok := scrape.Table(content, generalCaption)
+	if !ok {
+		return auction, nil, fmt.Errorf("auction: general not found")
+	}
+
+	if err := p.readGeneral(&auction, general); err != nil {
+		return auction, nil, fmt.Errorf(
+			"auction: %s: %w", auction.Character, err,
+		)
+	}
+
+	var pending []blockPages
+	for _, b := range blocks {
+		table, ok := scrape.Table(content, b.caption)
+		if !ok {
+			continue
+		}
+
+		if err := p.addEntries(&auction, b.typ, table); err != nil {
+			return auction, nil, fmt.Errorf(
+				"auction: %s: %w", auction.Character, err,
+			)
+		}
+
+		if last := p.readLastPage(table); last > 1 {
+			pending = append(pending, blockPages{typ: b.typ, last: last})
+		}
+	}
+
+	if table, ok := scrape.Table(content, blessingsCaption); ok {
+		auction.Blessings, err = p.readBlessings(table)
+		if err != nil {
+			return auction, nil, fmt.Errorf(
+				"auction: %s blessings: %w", auction.Character, err,
+			)
+		}
+	}
+
+	if table, ok := scrape.Table(content, charmsCaption); ok {
+		auction.Charms, err = p.readCharms(table)
+		if err != nil {
+			return auction, nil, fmt.Errorf(
+				"auction: %s charms: %w", auction.Character, err,
+			)
+		}
+	}
+
+	if table, ok := scrape.Table(content, bestiaryCaption); ok {
+		auction.Bestiary, err = p.readProgress(table)
+		if err != nil {
+			return auction, nil, fmt.Errorf(
+				"auction: %s bestiary: %w", auction.Character, err,
+			)
+		}
+	}
+
+	if table, ok := scrape.Table(content, bosstiaryCaption); ok {
+		auction.Bosstiary, err = p.readProgress(table)
+		if err != nil {
+			return auction, nil, fmt.Errorf(
+				"auction: %s bosstiary: %w", auction.Character, err,
+			)
+		}
+	}
+
+	if table, ok := scrape.Table(content, imbuementsCaption); ok {
+		auction.Imbuements = p.readNames(table)
+	}
+
+	if table, ok := scrape.Table(content, questLinesCaption); ok {
+		auction.QuestLines = p.readNames(table)
+	}
+
+	if table, ok := scrape.Table(content, achievsCaption); ok {
+		auction.Achievements = p.readNames(table)
+	}
+
+	return auction, pending, nil
+}
@@ -0,0 +1,278 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	auction, pending, err := p.parse(static.MustRead(t, "auction.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	cet := time.FixedZone("CET", 1*60*60)
+	cest := time.FixedZone("CEST", 2*60*60)
+	created := time.Date(2007, time.January, 5, 10, 1, 2, 0, cet)
+
+	want := tibia.Auction{
+		AuctionOverview: tibia.AuctionOverview{
+			ID:        1234567,
+			Character: "Kharsek Valdor",
+			Level:     1174,
+			Vocation:  tibia.VocationEliteKnight,
+			World:     "Antica",
+			Start:     time.Date(2023, time.July, 10, 10, 7, 0, 0, cest),
+			End:       time.Date(2023, time.July, 15, 10, 0, 0, 0, cest),
+			Bid:       125000,
+			BidStatus: tibia.AuctionBidStatusCurrent,
+		},
+		HitPoints:            6185,
+		Mana:                 1830,
+		Capacity:             12070,
+		Speed:                719,
+		Created:              created,
+		Experience:           26915108424,
+		Gold:                 1234567,
+		AchievementPoints:    1042,
+		AvailableCharmPoints: 1200,
+		SpentCharmPoints:     8500,
+		Skills: []tibia.AuctionSkill{
+			{Skill: tibia.SkillAxeFighting, Level: 28, Percent: 45.07},
+			{Skill: tibia.SkillClubFighting, Level: 22, Percent: 10},
+			{Skill: tibia.SkillDistanceFighting, Level: 30, Percent: 0.5},
+			{Skill: tibia.SkillFishing, Level: 40, Percent: 3.25},
+			{Skill: tibia.SkillFistFighting, Level: 20, Percent: 88.12},
+			{Skill: tibia.SkillMagicLevel, Level: 12, Percent: 1.5},
+			{Skill: tibia.SkillShielding, Level: 118, Percent: 62.34},
+			{Skill: tibia.SkillSwordFighting, Level: 128, Percent: 5.6},
+		},
+		Items: []tibia.AuctionItem{
+			{Name: "platinum coin", Count: 100},
+			{Name: "golden helmet", Count: 1},
+			{Name: "great health potion", Count: 250},
+		},
+		StoreItems: []tibia.AuctionItem{
+			{Name: "gold pouch", Count: 1},
+			{Name: "exercise sword", Count: 2},
+		},
+		Outfits: []tibia.AuctionOutfit{
+			{Name: "Citizen", Addons: 3},
+			{Name: "Hunter", Addons: 1},
+		},
+		StoreOutfits: []tibia.AuctionOutfit{
+			{Name: "Beastmaster", Addons: 2},
+		},
+		Mounts: []string{"Widow Queen", "Racing Bird"},
+		Charms: []tibia.AuctionCharm{
+			{Name: "Dodge", Cost: 2000},
+			{Name: "Low Blow", Cost: 1600},
+		},
+		Bestiary: []tibia.AuctionCreatureProgress{
+			{Name: "Dragon Lord", Kills: 5000, Step: 4},
+			{Name: "Rotworm", Kills: 250, Step: 2},
+		},
+		Bosstiary: []tibia.AuctionCreatureProgress{
+			{Name: "Ferumbras", Kills: 100, Step: 3},
+		},
+		Achievements: []string{"Allow Cookies?", "Bone Brother"},
+		Blessings: []tibia.AuctionBlessing{
+			{Name: "Adventurer's Blessing", Amount: 1},
+			{Name: "Blood of the Mountain", Amount: 2},
+			{Name: "Embrace of Tibia", Amount: 0},
+		},
+		Imbuements: []string{"Powerful Strike", "Powerful Vampirism"},
+		QuestLines: []string{"The Annihilator", "Wrath of the Emperor"},
+		Hirelings: tibia.AuctionHirelings{
+			Count:   2,
+			Jobs:    []string{"Banker", "Trader"},
+			Outfits: []string{"Hireling Banker"},
+		},
+	}
+
+	// The times are compared with Equal, as their locations differ.
+	for _, tc := range []struct {
+		name      string
+		got, want *time.Time
+	}{
+		{"start", &auction.Start, &want.Start},
+		{"end", &auction.End, &want.End},
+		{"created", &auction.Created, &want.Created},
+	} {
+		if !tc.got.Equal(*tc.want) {
+			t.Errorf("Wrong %s\nwant: %s\ngot: %s", tc.name, tc.want, tc.got)
+		}
+		*tc.got = *tc.want
+	}
+
+	if !reflect.DeepEqual(auction, want) {
+		t.Errorf("Wrong auction\nwant: %+v\ngot: %+v", want, auction)
+	}
+
+	wantPending := []blockPages{
+		{typ: itemsBlock, last: 2},
+		{typ: outfitsBlock, last: 2},
+	}
+
+	if !reflect.DeepEqual(pending, wantPending) {
+		t.Errorf(
+			"Wrong pending pages\nwant: %+v\ngot: %+v", wantPending, pending,
+		)
+	}
+}
+
+// countingLimiter is a ratelimit.Limiter that counts how many times it was
+// taken.
+type countingLimiter struct {
+	taken int
+}
+
+func (l *countingLimiter) Take() time.Time {
+	l.taken++
+	return time.Now()
+}
+
+func TestParserFragments(t *testing.T) {
+	var (
+		page      = static.MustRead(t, "auction.html")
+		items     = static.MustRead(t, "auction_items.json")
+		outfits   = static.MustRead(t, "auction_outfits.json")
+		fragments []url.Values
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != fragmentEndpoint {
+				_, _ = w.Write([]byte(page))
+				return
+			}
+
+			query := r.URL.Query()
+			fragments = append(fragments, query)
+
+			switch query.Get("type") {
+			case "0":
+				_, _ = w.Write([]byte(items))
+			case "4":
+				_, _ = w.Write([]byte(outfits))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	baseURL := parsers.BaseURL
+	parsers.BaseURL = srv.URL
+	t.Cleanup(func() { parsers.BaseURL = baseURL })
+
+	p := Parser{}
+	limiter := &countingLimiter{}
+
+	auction, err := p.Parse(
+		context.Background(),
+		Args{ID: 1234567},
+		parsers.Options{RateLimiter: limiter},
+	)
+	if err != nil {
+		t.Fatalf("failed to parse auction: %s", err)
+	}
+
+	wantItems := []tibia.AuctionItem{
+		{Name: "platinum coin", Count: 100},
+		{Name: "golden helmet", Count: 1},
+		{Name: "great health potion", Count: 250},
+		{Name: "magic plate armor", Count: 1},
+		{Name: "dragon ham", Count: 10},
+	}
+
+	if !reflect.DeepEqual(auction.Items, wantItems) {
+		t.Errorf("Wrong items\nwant: %+v\ngot: %+v", wantItems, auction.Items)
+	}
+
+	wantOutfits := []tibia.AuctionOutfit{
+		{Name: "Citizen", Addons: 3},
+		{Name: "Hunter", Addons: 1},
+		{Name: "Knight", Addons: 0},
+	}
+
+	if !reflect.DeepEqual(auction.Outfits, wantOutfits) {
+		t.Errorf(
+			"Wrong outfits\nwant: %+v\ngot: %+v", wantOutfits, auction.Outfits,
+		)
+	}
+
+	wantFragments := []url.Values{
+		{"auctionid": {"1234567"}, "type": {"0"}, "currentpage": {"2"}},
+		{"auctionid": {"1234567"}, "type": {"4"}, "currentpage": {"2"}},
+	}
+
+	if !reflect.DeepEqual(fragments, wantFragments) {
+		t.Errorf(
+			"Wrong fragments\nwant: %v\ngot: %v", wantFragments, fragments,
+		)
+	}
+
+	// The auction page and both fragments.
+	if limiter.taken != 3 {
+		t.Errorf(
+			"Wrong rate limiter takes\nwant: %d\ngot: %d", 3, limiter.taken,
+		)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, _, err := p.parse(static.MustRead(t, "spells.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{{}, {ID: -1}} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestAuctionURL(t *testing.T) {
+	p := Parser{}
+
+	u, err := url.Parse(p.auctionURL(Args{ID: 1234567}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic":  {"currentcharactertrades"},
+		"page":      {"details"},
+		"auctionid": {"1234567"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
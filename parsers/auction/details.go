@@ -0,0 +1,228 @@
+package auction
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	headerIndexer = `class="LabelH"`
+
+	listSeparator = ", "
+	percentSuffix = " %"
+)
+
+// readGeneral reads the general table, which is made of stat rows, such as
+// "Hit Points: | 6,185", and skill rows, such as
+// "Axe Fighting | 28 | 45.07 %".
+func (p *Parser) readGeneral(auction *tibia.Auction, table string) error {
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		label := scrape.Text(cells[0])
+		if stat, ok := strings.CutSuffix(label, ":"); ok {
+			if err := p.readStat(auction, stat, cells[1]); err != nil {
+				return fmt.Errorf("%s: %w", stat, err)
+			}
+			continue
+		}
+
+		if len(cells) < 3 {
+			continue
+		}
+
+		skill, err := p.readSkill(label, cells[1], cells[2])
+		if err != nil {
+			return fmt.Errorf("%s: %w", label, err)
+		}
+
+		auction.Skills = append(auction.Skills, skill)
+	}
+
+	return nil
+}
+
+func (p *Parser) readStat(auction *tibia.Auction, stat, val string) error {
+	var (
+		field *int
+		err   error
+	)
+
+	switch stat {
+	case "Hit Points":
+		field = &auction.HitPoints
+	case "Mana":
+		field = &auction.Mana
+	case "Capacity":
+		field = &auction.Capacity
+	case "Speed":
+		field = &auction.Speed
+	case "Experience":
+		field = &auction.Experience
+	case "Gold":
+		field = &auction.Gold
+	case "Achievement Points":
+		field = &auction.AchievementPoints
+	case "Available Charm Points":
+		field = &auction.AvailableCharmPoints
+	case "Spent Charm Points":
+		field = &auction.SpentCharmPoints
+	case "Hirelings":
+		field = &auction.Hirelings.Count
+	case "Creation Date":
+		auction.Created, err = scrape.DateTime(val)
+	case "Hireling Jobs":
+		auction.Hirelings.Jobs = p.readList(val)
+	case "Hireling Outfits":
+		auction.Hirelings.Outfits = p.readList(val)
+	}
+
+	if field != nil {
+		*field, err = scrape.Int(val)
+	}
+
+	return err
+}
+
+// readList reads lines such as "Banker, Trader".
+func (p *Parser) readList(s string) []string {
+	s = scrape.Text(s)
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, listSeparator)
+}
+
+func (p *Parser) readSkill(
+	name, level, percent string,
+) (tibia.AuctionSkill, error) {
+	var (
+		skill tibia.AuctionSkill
+		err   error
+	)
+
+	skill.Skill, err = tibia.SkillFromString(name)
+	if err != nil {
+		return skill, err
+	}
+
+	skill.Level, err = scrape.Int(level)
+	if err != nil {
+		return skill, fmt.Errorf("level: %w", err)
+	}
+
+	percent = strings.TrimSuffix(scrape.Text(percent), percentSuffix)
+	skill.Percent, err = strconv.ParseFloat(percent, 64)
+	if err != nil {
+		return skill, fmt.Errorf("percent: %w", err)
+	}
+
+	return skill, nil
+}
+
+// readBlessings reads rows such as "2 | Blood of the Mountain".
+func (p *Parser) readBlessings(table string) ([]tibia.AuctionBlessing, error) {
+	var blessings []tibia.AuctionBlessing
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 || strings.Contains(row, headerIndexer) {
+			continue
+		}
+
+		blessing := tibia.AuctionBlessing{
+			Name: scrape.Text(cells[1]),
+		}
+
+		var err error
+
+		blessing.Amount, err = scrape.Int(cells[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", blessing.Name, err)
+		}
+
+		blessings = append(blessings, blessing)
+	}
+	return blessings, nil
+}
+
+// readCharms reads rows such as "2,000 | Dodge".
+func (p *Parser) readCharms(table string) ([]tibia.AuctionCharm, error) {
+	var charms []tibia.AuctionCharm
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 || strings.Contains(row, headerIndexer) {
+			continue
+		}
+
+		charm := tibia.AuctionCharm{
+			Name: scrape.Text(cells[1]),
+		}
+
+		var err error
+
+		charm.Cost, err = scrape.Int(cells[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", charm.Name, err)
+		}
+
+		charms = append(charms, charm)
+	}
+	return charms, nil
+}
+
+// readProgress reads the bestiary and bosstiary tables, whose rows are such
+// as "4 | 5,000 | Dragon Lord".
+func (p *Parser) readProgress(
+	table string,
+) ([]tibia.AuctionCreatureProgress, error) {
+	var progress []tibia.AuctionCreatureProgress
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 3 || strings.Contains(row, headerIndexer) {
+			continue
+		}
+
+		creature := tibia.AuctionCreatureProgress{
+			Name: scrape.Text(cells[2]),
+		}
+
+		var err error
+
+		creature.Step, err = scrape.Int(cells[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s step: %w", creature.Name, err)
+		}
+
+		creature.Kills, err = scrape.Int(cells[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s kills: %w", creature.Name, err)
+		}
+
+		progress = append(progress, creature)
+	}
+	return progress, nil
+}
+
+// readNames reads tables whose rows are made of a single name, such as the
+// imbuements table.
+func (p *Parser) readNames(table string) []string {
+	var names []string
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) == 0 {
+			continue
+		}
+
+		if name := scrape.Text(cells[0]); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,238 @@
+package auction
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	fragmentEndpoint = "/websiteservices/handle_charactertrades.php"
+
+	// fragmentContentLength is the aprox Content-Length of the data returned
+	// by the fragment endpoint.
+	fragmentContentLength = 20000
+)
+
+// blockType is the type tibia.com uses to identify a paginated block of an
+// auction when fetching its pages.
+type blockType int
+
+const (
+	itemsBlock blockType = iota
+	storeItemsBlock
+	mountsBlock
+	storeMountsBlock
+	outfitsBlock
+	storeOutfitsBlock
+)
+
+// blocks are the paginated blocks of an auction, along with their captions.
+var blocks = []struct {
+	caption string
+	typ     blockType
+}{
+	{"Item Summary", itemsBlock},
+	{"Store Item Summary", storeItemsBlock},
+	{"Mounts", mountsBlock},
+	{"Store Mounts", storeMountsBlock},
+	{"Outfits", outfitsBlock},
+	{"Store Outfits", storeOutfitsBlock},
+}
+
+// blockPages is a paginated block whose pages after the first one must still
+// be fetched.
+type blockPages struct {
+	typ  blockType
+	last int
+}
+
+const (
+	iconIndexer = `<div class="CVIcon`
+	imgIndexer  = "<img "
+
+	pageIndexer = "currentpage="
+
+	countSeparator = "x "
+	addonsIndexer  = "_"
+)
+
+// fetchPages fetches every page of a paginated block after the first one and
+// adds their entries to the auction.
+//
+// The pages are fetched one after the other, so the RateLimiter of opts is
+// honored between them.
+func (p *Parser) fetchPages(
+	ctx context.Context,
+	auction *tibia.Auction,
+	pages blockPages,
+	opts parsers.Options,
+) error {
+	for page := 2; page <= pages.last; page++ {
+		rawURL := p.fragmentURL(auction.ID, pages.typ, page)
+
+		data, err := fetch.Get(ctx, rawURL, opts, fragmentContentLength)
+		if err != nil {
+			return fmt.Errorf("block %d page %d: %w", pages.typ, page, err)
+		}
+
+		fragment, err := p.readFragment(data)
+		if err != nil {
+			return fmt.Errorf("block %d page %d: %w", pages.typ, page, err)
+		}
+
+		if err := p.addEntries(auction, pages.typ, fragment); err != nil {
+			return fmt.Errorf("block %d page %d: %w", pages.typ, page, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Parser) fragmentURL(id int, typ blockType, page int) string {
+	vals := url.Values{}
+	vals.Set("auctionid", strconv.Itoa(id))
+	vals.Set("type", strconv.Itoa(int(typ)))
+	vals.Set("currentpage", strconv.Itoa(page))
+	return parsers.BaseURL + fragmentEndpoint + "?" + vals.Encode()
+}
+
+// readFragment reads the HTML of a page of a paginated block, which tibia.com
+// returns wrapped in a JSON object such as
+// `{"AjaxObjects":[{"Data":"<tr>...</tr>","DataType":"HTML"}]}`.
+func (p *Parser) readFragment(data string) (string, error) {
+	var res struct {
+		AjaxObjects []struct {
+			Data string `json:"Data"`
+		} `json:"AjaxObjects"`
+	}
+
+	if err := json.Unmarshal([]byte(data), &res); err != nil {
+		return "", fmt.Errorf("failed to unmarshal fragment: %w", err)
+	}
+
+	if len(res.AjaxObjects) == 0 {
+		return "", fmt.Errorf("fragment is empty")
+	}
+
+	return res.AjaxObjects[0].Data, nil
+}
+
+// readLastPage reads the last page of a paginated block from its page links.
+func (p *Parser) readLastPage(block string) int {
+	last := 1
+	for {
+		idx := strings.Index(block, pageIndexer)
+		if idx == -1 {
+			return last
+		}
+		block = block[idx+len(pageIndexer):]
+
+		end := strings.IndexFunc(block, func(r rune) bool {
+			return r < '0' || r > '9'
+		})
+		if end == -1 {
+			end = len(block)
+		}
+
+		if page, err := strconv.Atoi(block[:end]); err == nil && page > last {
+			last = page
+		}
+	}
+}
+
+// addEntries adds the entries of a page of a paginated block to the auction.
+// The entries are displayed as icons whose titles hold their names.
+func (p *Parser) addEntries(
+	auction *tibia.Auction,
+	typ blockType,
+	block string,
+) error {
+	parts := strings.Split(block, iconIndexer)
+	for _, part := range parts[1:] {
+		title, _ := scrape.Attr(iconIndexer+part, "title")
+
+		var img string
+		if idx := strings.Index(part, imgIndexer); idx != -1 {
+			img, _ = scrape.Attr(part[idx:], "src")
+		}
+
+		switch typ {
+		case itemsBlock:
+			auction.Items = append(auction.Items, p.readItem(title))
+		case storeItemsBlock:
+			auction.StoreItems = append(auction.StoreItems, p.readItem(title))
+		case mountsBlock:
+			auction.Mounts = append(auction.Mounts, title)
+		case storeMountsBlock:
+			auction.StoreMounts = append(auction.StoreMounts, title)
+		case outfitsBlock, storeOutfitsBlock:
+			outfit, err := p.readOutfit(title, img)
+			if err != nil {
+				return err
+			}
+
+			if typ == outfitsBlock {
+				auction.Outfits = append(auction.Outfits, outfit)
+			} else {
+				auction.StoreOutfits = append(auction.StoreOutfits, outfit)
+			}
+		}
+	}
+
+	return nil
+}
+
+// readItem reads titles such as "250x great health potion". Titles without a
+// count are of a single item.
+func (p *Parser) readItem(title string) tibia.AuctionItem {
+	item := tibia.AuctionItem{
+		Name:  title,
+		Count: 1,
+	}
+
+	count, name, ok := strings.Cut(title, countSeparator)
+	if !ok {
+		return item
+	}
+
+	if n, err := scrape.Int(count); err == nil {
+		item.Name = name
+		item.Count = n
+	}
+
+	return item
+}
+
+// readOutfit reads an outfit, whose addons are part of the name of its image,
+// i.e. ".../outfits/128_3.gif" is an outfit with both addons.
+func (p *Parser) readOutfit(title, img string) (tibia.AuctionOutfit, error) {
+	outfit := tibia.AuctionOutfit{
+		Name: title,
+	}
+
+	name := strings.TrimSuffix(path.Base(img), path.Ext(img))
+
+	idx := strings.LastIndex(name, addonsIndexer)
+	if idx == -1 {
+		return outfit, fmt.Errorf("%s: addons not found", title)
+	}
+
+	var err error
+
+	outfit.Addons, err = strconv.Atoi(name[idx+len(addonsIndexer):])
+	if err != nil {
+		return outfit, fmt.Errorf("%s addons: %w", title, err)
+	}
+
+	return outfit, nil
+}
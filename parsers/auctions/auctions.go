@@ -0,0 +1,132 @@
+// Package auctions provides an implementation of the Parser interface for
+// parsing a page of the current auctions from the tibia.com Char Bazaar.
+//
+// To use the auctions package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the desired filters to fetch the
+// HTML content from the Char Bazaar page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package auctions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/phenpessoa/tibia-crawler/internal/bazaar"
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/charactertrade/?subtopic=currentcharactertrades"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the auctions endpoint.
+	contentLength = 250000
+)
+
+var _ parsers.Parser[Args, tibia.Auctions] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing a page of the current auctions from the tibia.com Char Bazaar.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	Filters
+
+	// Page is the page to be parsed, starting at 1.
+	//
+	// If Page is 0, the first page is parsed. Page must not be negative.
+	// Otherwise, parsers.ErrInvalidArgs is returned.
+	Page int
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Auctions, error) {
+	if err := args.Validate(); err != nil {
+		return tibia.Auctions{}, fmt.Errorf("auctions: %w", err)
+	}
+
+	if args.Page < 0 {
+		return tibia.Auctions{}, fmt.Errorf(
+			"auctions: invalid page %d: %w", args.Page, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if args.Page == 0 {
+		args.Page = 1
+	}
+
+	data, err := fetch.Get(ctx, p.auctionsURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Auctions{}, fmt.Errorf("auctions: %w", err)
+	}
+
+	auctions, err := p.parse(data, args)
+	if err != nil {
+		return tibia.Auctions{}, fmt.Errorf(
+			"auctions: failed to parse body: %w", err,
+		)
+	}
+
+	return auctions, nil
+}
+
+func (p *Parser) auctionsURL(args Args) string {
+	vals := args.Values()
+	vals.Set("currentpage", strconv.Itoa(args.Page))
+	return p.URL() + "&" + vals.Encode()
+}
+
+const auctionsCaption = "Current Auctions"
+
+func (p *Parser) parse(data string, args Args) (tibia.Auctions, error) {
+	auctions := tibia.Auctions{
+		Page: args.Page,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return auctions, fmt.Errorf("auctions: %w", err)
+	}
+
+	table, ok := scrape.Table(content, auctionsCaption)
+	if !ok {
+		return auctions, fmt.Errorf("auctions: %w", parsers.ErrNotFound)
+	}
+
+	cards, err := bazaar.ReadAuctions(table)
+	if err != nil {
+		return auctions, fmt.Errorf("auctions: %w", err)
+	}
+
+	for _, card := range cards {
+		auctions.Auctions = append(auctions.Auctions, card.Overview)
+	}
+
+	auctions.TotalResults, auctions.TotalPages, err = bazaar.ReadResults(
+		content,
+	)
+	if err != nil {
+		return auctions, fmt.Errorf("auctions: %w", err)
+	}
+
+	return auctions, nil
+}
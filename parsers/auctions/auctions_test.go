@@ -0,0 +1,237 @@
+package auctions
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	auctions, err := p.parse(static.MustRead(t, "auctions.html"), Args{Page: 1})
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	cest := time.FixedZone("CEST", 2*60*60)
+
+	want := tibia.Auctions{
+		Page:         1,
+		TotalPages:   3,
+		TotalResults: 52,
+		Auctions: []tibia.AuctionOverview{
+			{
+				ID:        1234567,
+				Character: "Kharsek Valdor",
+				Level:     1174,
+				Vocation:  tibia.VocationEliteKnight,
+				World:     "Antica",
+				Start:     time.Date(2023, time.July, 10, 10, 7, 0, 0, cest),
+				End:       time.Date(2023, time.July, 15, 10, 0, 0, 0, cest),
+				Bid:       125000,
+				BidStatus: tibia.AuctionBidStatusCurrent,
+			},
+			{
+				ID:        1234601,
+				Character: "Flora Greenleaf",
+				Level:     999,
+				Vocation:  tibia.VocationElderDruid,
+				World:     "Bona",
+				Start:     time.Date(2023, time.July, 11, 18, 30, 0, 0, cest),
+				End:       time.Date(2023, time.July, 16, 18, 0, 0, 0, cest),
+				Bid:       57000,
+				BidStatus: tibia.AuctionBidStatusMinimum,
+			},
+			{
+				ID:        1234777,
+				Character: "Rookie Nobody",
+				Level:     8,
+				Vocation:  tibia.VocationNone,
+				World:     "Celesta",
+				Start:     time.Date(2023, time.July, 12, 9, 15, 0, 0, cest),
+				End:       time.Date(2023, time.July, 14, 9, 0, 0, 0, cest),
+				Bid:       57,
+				BidStatus: tibia.AuctionBidStatusMinimum,
+			},
+		},
+	}
+
+	if len(auctions.Auctions) != len(want.Auctions) {
+		t.Fatalf(
+			"Wrong auctions count\nwant: %d\ngot: %d",
+			len(want.Auctions), len(auctions.Auctions),
+		)
+	}
+
+	// The times are compared with Equal, as their locations differ.
+	for i, got := range auctions.Auctions {
+		w := want.Auctions[i]
+		if !got.Start.Equal(w.Start) || !got.End.Equal(w.End) {
+			t.Errorf(
+				"Wrong times of %s\nwant: %s %s\ngot: %s %s",
+				w.Character, w.Start, w.End, got.Start, got.End,
+			)
+		}
+		got.Start, got.End = w.Start, w.End
+		auctions.Auctions[i] = got
+	}
+
+	if !reflect.DeepEqual(auctions, want) {
+		t.Errorf("Wrong auctions\nwant: %+v\ngot: %+v", want, auctions)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "spells.html"), Args{})
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{
+		{Page: -1},
+		{Filters: Filters{World: "invalid world 1"}},
+		{Filters: Filters{MinLevel: -1}},
+		{Filters: Filters{MinLevel: 200, MaxLevel: 100}},
+		{Filters: Filters{MinSkill: 100}},
+	} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error for %+v\nwant: %s\ngot: %v",
+				args, parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestAuctionsURL(t *testing.T) {
+	p := Parser{}
+
+	var (
+		pvpType   = tibia.PvPTypeRetroOpenPvP
+		battleEye = tibia.BattleEyeStatusInitiallyProtected
+		skill     = tibia.SkillMagicLevel
+	)
+
+	for _, tc := range []struct {
+		args Args
+		want url.Values
+	}{
+		{
+			args: Args{Page: 1},
+			want: url.Values{
+				"subtopic":          {"currentcharactertrades"},
+				"filter_profession": {"0"},
+				"order_column":      {"101"},
+				"order_direction":   {"1"},
+				"currentpage":       {"1"},
+			},
+		},
+		{
+			args: Args{
+				Filters: Filters{
+					World:      "Antica",
+					PvPType:    &pvpType,
+					BattleEye:  &battleEye,
+					Vocation:   tibia.VocationElderDruid,
+					MinLevel:   100,
+					MaxLevel:   500,
+					Skill:      &skill,
+					MinSkill:   80,
+					Order:      tibia.AuctionOrderBid,
+					Descending: true,
+				},
+				Page: 3,
+			},
+			want: url.Values{
+				"subtopic":                  {"currentcharactertrades"},
+				"filter_world":              {"Antica"},
+				"filter_worldpvptype":       {"3"},
+				"filter_worldbattleyestate": {"1"},
+				"filter_profession":         {"2"},
+				"filter_levelrangefrom":     {"100"},
+				"filter_levelrangeto":       {"500"},
+				"filter_skillid":            {"1"},
+				"filter_skillrangefrom":     {"80"},
+				"order_column":              {"100"},
+				"order_direction":           {"0"},
+				"currentpage":               {"3"},
+			},
+		},
+	} {
+		u, err := url.Parse(p.auctionsURL(tc.args))
+		if err != nil {
+			t.Fatalf("failed to parse url: %s", err)
+		}
+
+		if got := u.Query(); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Wrong query\nwant: %v\ngot: %v", tc.want, got)
+		}
+	}
+}
+
+func TestFiltersBattleEye(t *testing.T) {
+	for _, tc := range []struct {
+		status tibia.BattleEyeStatus
+		want   []string
+	}{
+		{status: tibia.BattleEyeStatusAnyWorld, want: nil},
+		{status: tibia.BattleEyeStatusInitiallyProtected, want: []string{"1"}},
+		{status: tibia.BattleEyeStatusProtected, want: []string{"2"}},
+		{status: tibia.BattleEyeStatusUnprotected, want: []string{"3"}},
+	} {
+		status := tc.status
+		got := Filters{BattleEye: &status}.Values()[battleEyeKey]
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf(
+				"Wrong value for %s\nwant: %v\ngot: %v", status, tc.want, got,
+			)
+		}
+	}
+}
+
+func TestFiltersVocation(t *testing.T) {
+	for _, tc := range []struct {
+		vocation tibia.Vocation
+		want     string
+	}{
+		{vocation: tibia.VocationAll, want: "filter_profession=0"},
+		{vocation: tibia.VocationNone, want: "filter_profession=1"},
+		{vocation: tibia.VocationDruid, want: "filter_profession=2"},
+		{vocation: tibia.VocationElderDruid, want: "filter_profession=2"},
+		{vocation: tibia.VocationKnight, want: "filter_profession=3"},
+		{vocation: tibia.VocationEliteKnight, want: "filter_profession=3"},
+		{vocation: tibia.VocationPaladin, want: "filter_profession=4"},
+		{vocation: tibia.VocationRoyalPaladin, want: "filter_profession=4"},
+		{vocation: tibia.VocationSorcerer, want: "filter_profession=5"},
+		{
+			vocation: tibia.VocationMasterSorcerer,
+			want:     "filter_profession=5",
+		},
+	} {
+		vals := Filters{Vocation: tc.vocation}.Values()
+		got := url.Values{vocationKey: vals[vocationKey]}.Encode()
+		if got != tc.want {
+			t.Errorf(
+				"Wrong query for %s\nwant: %s\ngot: %s",
+				tc.vocation, tc.want, got,
+			)
+		}
+	}
+}
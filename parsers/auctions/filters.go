@@ -0,0 +1,194 @@
+package auctions
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// Filters are the filters of the Char Bazaar search form.
+//
+// The current auctions and the auction history are filtered the same way, so
+// Filters is shared by the parsers of both lists.
+type Filters struct {
+	// World is the name of the world to filter by.
+	//
+	// If World is empty, auctions of all worlds are included. Otherwise, it
+	// must be a valid world name, see tibia.IsWorldNameValid, or
+	// parsers.ErrInvalidArgs is returned.
+	World string
+
+	// PvPType is the PvP type of the worlds to filter by.
+	//
+	// If PvPType is nil, worlds of every PvP type are included.
+	PvPType *tibia.PvPType
+
+	// BattleEye is the Battle Eye status of the worlds to filter by.
+	//
+	// If BattleEye is nil or tibia.BattleEyeStatusAnyWorld, worlds with any
+	// Battle Eye status are included.
+	BattleEye *tibia.BattleEyeStatus
+
+	// Vocation is the vocation to filter by.
+	//
+	// Promoted vocations are filtered the same way as their base vocation.
+	Vocation tibia.Vocation
+
+	// MinLevel and MaxLevel are the level range to filter by. A value of 0
+	// means the range is not bounded on that side.
+	//
+	// They must not be negative and MinLevel must not be greater than
+	// MaxLevel, unless MaxLevel is 0. Otherwise, parsers.ErrInvalidArgs is
+	// returned.
+	MinLevel, MaxLevel int
+
+	// Skill is the skill to filter by, along with MinSkill and MaxSkill.
+	//
+	// If Skill is nil, the skills of the characters are not filtered.
+	Skill *tibia.Skill
+
+	// MinSkill and MaxSkill are the skill range to filter by. A value of 0
+	// means the range is not bounded on that side.
+	//
+	// They follow the same rules as MinLevel and MaxLevel, and they must be 0
+	// if Skill is nil. Otherwise, parsers.ErrInvalidArgs is returned.
+	MinSkill, MaxSkill int
+
+	// Order is the column the auctions are ordered by.
+	Order tibia.AuctionOrder
+
+	// Descending reports whether the auctions are ordered in descending
+	// order. By default, they are ordered in ascending order.
+	Descending bool
+}
+
+// Validate reports whether the filters are valid. The returned error wraps
+// parsers.ErrInvalidArgs.
+func (f Filters) Validate() error {
+	if f.World != "" && !tibia.IsWorldNameValid(f.World) {
+		return fmt.Errorf(
+			"invalid world %q: %w", f.World, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if !isRangeValid(f.MinLevel, f.MaxLevel) {
+		return fmt.Errorf(
+			"invalid level range %d-%d: %w",
+			f.MinLevel, f.MaxLevel, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if !isRangeValid(f.MinSkill, f.MaxSkill) ||
+		(f.Skill == nil && (f.MinSkill != 0 || f.MaxSkill != 0)) {
+		return fmt.Errorf(
+			"invalid skill range %d-%d: %w",
+			f.MinSkill, f.MaxSkill, parsers.ErrInvalidArgs,
+		)
+	}
+
+	return nil
+}
+
+func isRangeValid(min, max int) bool {
+	return min >= 0 && max >= 0 && (max == 0 || min <= max)
+}
+
+const (
+	worldKey        = "filter_world"
+	pvpTypeKey      = "filter_worldpvptype"
+	battleEyeKey    = "filter_worldbattleyestate"
+	vocationKey     = "filter_profession"
+	minLevelKey     = "filter_levelrangefrom"
+	maxLevelKey     = "filter_levelrangeto"
+	minSkillKey     = "filter_skillrangefrom"
+	maxSkillKey     = "filter_skillrangeto"
+	orderDirKey     = "order_direction"
+	descendingOrder = "0"
+	ascendingOrder  = "1"
+)
+
+// battleEyeVals maps the Battle Eye statuses to the values of the search form,
+// which differ from the ones used by the highscores.
+var battleEyeVals = map[tibia.BattleEyeStatus]string{
+	tibia.BattleEyeStatusInitiallyProtected: "1",
+	tibia.BattleEyeStatusProtected:          "2",
+	tibia.BattleEyeStatusUnprotected:        "3",
+}
+
+// vocationVals maps the vocations to the values of the search form, which
+// lists them alphabetically, unlike the highscores. Promoted vocations share
+// the value of their base vocation.
+var vocationVals = map[tibia.Vocation]string{
+	tibia.VocationAll:            "0",
+	tibia.VocationNone:           "1",
+	tibia.VocationDruid:          "2",
+	tibia.VocationElderDruid:     "2",
+	tibia.VocationKnight:         "3",
+	tibia.VocationEliteKnight:    "3",
+	tibia.VocationPaladin:        "4",
+	tibia.VocationRoyalPaladin:   "4",
+	tibia.VocationSorcerer:       "5",
+	tibia.VocationMasterSorcerer: "5",
+}
+
+// Values returns the query parameters of the search form for the filters.
+//
+// The Char Bazaar search form names its fields differently from the other
+// tibia.com pages, so only the QueryVal of the PvP type is used. The Battle
+// Eye statuses and the vocations are numbered differently as well, see
+// battleEyeVals and vocationVals.
+func (f Filters) Values() url.Values {
+	vals := url.Values{}
+
+	if f.World != "" {
+		vals.Set(worldKey, f.World)
+	}
+
+	if f.PvPType != nil {
+		vals.Set(pvpTypeKey, f.PvPType.QueryVal())
+	}
+
+	if f.BattleEye != nil {
+		if val, ok := battleEyeVals[*f.BattleEye]; ok {
+			vals.Set(battleEyeKey, val)
+		}
+	}
+
+	// Unknown vocations are not filtered, like tibia.VocationAll.
+	vocation, ok := vocationVals[f.Vocation]
+	if !ok {
+		vocation = vocationVals[tibia.VocationAll]
+	}
+	vals.Set(vocationKey, vocation)
+
+	if f.MinLevel != 0 {
+		vals.Set(minLevelKey, strconv.Itoa(f.MinLevel))
+	}
+
+	if f.MaxLevel != 0 {
+		vals.Set(maxLevelKey, strconv.Itoa(f.MaxLevel))
+	}
+
+	if f.Skill != nil {
+		vals.Set(f.Skill.QueryKey(), f.Skill.QueryVal())
+		if f.MinSkill != 0 {
+			vals.Set(minSkillKey, strconv.Itoa(f.MinSkill))
+		}
+		if f.MaxSkill != 0 {
+			vals.Set(maxSkillKey, strconv.Itoa(f.MaxSkill))
+		}
+	}
+
+	vals.Set(f.Order.QueryKey(), f.Order.QueryVal())
+
+	direction := ascendingOrder
+	if f.Descending {
+		direction = descendingOrder
+	}
+	vals.Set(orderDirKey, direction)
+
+	return vals
+}
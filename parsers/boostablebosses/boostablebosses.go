@@ -8,6 +8,11 @@
 // Boostable Bosses Library page, parse it, and return the parsed data.
 // Additionally, the URL method can be used to retrieve the specific tibia.com
 // endpoint being parsed.
+//
+// Like every tibia.com page, the Boostable Bosses Library page also displays
+// today's boosted creature, so it is parsed as well. If it can not be parsed,
+// BoostedCreature is left as the zero value and the bosses are still
+// returned.
 package boostablebosses
 
 import (
@@ -21,6 +26,7 @@ import (
 	"time"
 
 	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/parsers/boosted"
 	"github.com/phenpessoa/tibia-crawler/tibia"
 )
 
@@ -270,6 +276,12 @@ func (p *Parser) parse(data string) error {
 		}
 	}
 
+	// The boss list is the main content of the page, so a missing or changed
+	// header does not fail the parse, BoostedCreature is left empty instead.
+	if header, err := boosted.FromHTML(data); err == nil {
+		parsed.BoostedCreature = header.Creature
+	}
+
 	p.store(parsed)
 	return nil
 }
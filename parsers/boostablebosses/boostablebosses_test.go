@@ -57,6 +57,18 @@ func TestParser(t *testing.T) {
 		return
 	}
 
+	creature := tibia.BoostedCreature{
+		Name: "Salamander",
+		ImageURL: "https://static.tibia.com/images/global/header/monsters/" +
+			"salamander.gif",
+	}
+	if p.cachedBosses.BoostedCreature != creature {
+		t.Errorf(
+			"Wrong boosted creature\nwant: %+v\ngot: %+v",
+			creature, p.cachedBosses.BoostedCreature,
+		)
+	}
+
 	for _, tc := range []struct {
 		idx       int
 		name      string
@@ -120,3 +132,28 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParserMissingBoostedCreature(t *testing.T) {
+	data := static.MustRead(t, "boostablebosses.html")
+
+	// Without the boosted creature on the header, the bosses must still be
+	// parsed.
+	data = strings.Replace(data, `<img id="Monster"`, `<img id="Other"`, 1)
+
+	p := Parser{}
+
+	if err := p.parse(data); err != nil {
+		t.Fatalf("failed to parse data: %s", err)
+	}
+
+	if p.cachedBosses.BoostedCreature != (tibia.BoostedCreature{}) {
+		t.Errorf(
+			"Wrong boosted creature\nwant: %+v\ngot: %+v",
+			tibia.BoostedCreature{}, p.cachedBosses.BoostedCreature,
+		)
+	}
+
+	if len(p.cachedBosses.Bosses) == 0 {
+		t.Errorf("bosses were not parsed")
+	}
+}
@@ -0,0 +1,144 @@
+// Package boosted provides an implementation of the Parser interface for
+// parsing today's boosted creature and boosted boss from the header of the
+// tibia.com pages.
+//
+// To use the boosted package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called to fetch the HTML content from the
+// Creatures Library page, parse its header, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// The header is the same on every tibia.com page, so if a page was already
+// fetched for a different purpose, FromHTML can be used to read the boosted
+// creature and boss from it without making another request.
+package boosted
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/library/?subtopic=creatures"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the creatures endpoint.
+	contentLength = 60000
+)
+
+var _ parsers.Parser[Args, tibia.Boosted] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing today's boosted creature and boosted boss from the header of the
+// tibia.com Creatures Library page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface, but it is
+// not used by this implementation.
+type Args struct{}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Boosted, error) {
+	data, err := fetch.Get(ctx, p.URL(), opts, contentLength)
+	if err != nil {
+		return tibia.Boosted{}, fmt.Errorf("boosted: %w", err)
+	}
+
+	boosted, err := FromHTML(data)
+	if err != nil {
+		return tibia.Boosted{}, fmt.Errorf(
+			"boosted: failed to parse body: %w", err,
+		)
+	}
+
+	return boosted, nil
+}
+
+const (
+	creatureIndexer = `<img id="Monster"`
+	creaturePrefix  = "Today's boosted creature: "
+
+	bossIndexer = `<img id="Boss"`
+	bossPrefix  = "Today's boosted boss: "
+)
+
+// FromHTML reads today's boosted creature and boosted boss from the header of
+// data, which can be the HTML of any tibia.com page.
+//
+// data can be either the UTF-8 body returned by the parsers of this module or
+// the raw ISO-8859-1 body served by tibia.com.
+//
+// If the header is not found in data, an error wrapping parsers.ErrNotFound
+// is returned.
+func FromHTML(data string) (tibia.Boosted, error) {
+	var boosted tibia.Boosted
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return boosted, fmt.Errorf("boosted: %w", err)
+	}
+
+	name, img, err := readImg(content, creatureIndexer, creaturePrefix)
+	if err != nil {
+		return boosted, fmt.Errorf("boosted: creature: %w", err)
+	}
+	boosted.Creature = tibia.BoostedCreature{
+		Name:     name,
+		ImageURL: img,
+	}
+
+	name, img, err = readImg(content, bossIndexer, bossPrefix)
+	if err != nil {
+		return boosted, fmt.Errorf("boosted: boss: %w", err)
+	}
+	boosted.Boss = tibia.BoostableBoss{
+		Name:      name,
+		ImageURL:  img,
+		IsBoosted: true,
+	}
+
+	return boosted, nil
+}
+
+// readImg reads the name and the image URL from header images such as
+// `<img id="Monster" title="Today's boosted creature: Salamander"
+// src="https://static.tibia.com/images/global/header/monsters/salamander.gif"
+// ... />`.
+func readImg(content, indexer, prefix string) (name, img string, err error) {
+	idx := strings.Index(content, indexer)
+	if idx == -1 {
+		return "", "", parsers.ErrNotFound
+	}
+
+	tag := content[idx:]
+
+	title, ok := scrape.Attr(tag, "title")
+	if !ok || !strings.HasPrefix(title, prefix) {
+		return "", "", fmt.Errorf("name not found")
+	}
+
+	img, ok = scrape.Attr(tag, "src")
+	if !ok {
+		return "", "", fmt.Errorf("image not found")
+	}
+
+	return strings.TrimPrefix(title, prefix), img, nil
+}
@@ -0,0 +1,89 @@
+package boosted
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+var want = tibia.Boosted{
+	Creature: tibia.BoostedCreature{
+		Name: "Salamander",
+		ImageURL: "https://static.tibia.com/images/global/header/monsters/" +
+			"salamander.gif",
+	},
+	Boss: tibia.BoostableBoss{
+		Name: "Utua Stone Sting",
+		ImageURL: "https://static.tibia.com/images/global/header/monsters/" +
+			"utua.gif",
+		IsBoosted: true,
+	},
+}
+
+func TestFromHTML(t *testing.T) {
+	for _, file := range []string{
+		"boostablebosses.html",
+		"latestnews.html",
+		"character.html",
+	} {
+		t.Run(file, func(t *testing.T) {
+			boosted, err := FromHTML(static.MustRead(t, file))
+			if err != nil {
+				t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+			}
+
+			if boosted != want {
+				t.Errorf("Wrong boosted\nwant: %+v\ngot: %+v", want, boosted)
+			}
+		})
+	}
+}
+
+func TestFromHTMLNotFound(t *testing.T) {
+	data := scrape.ContentStart + "<p>No header.</p>" + scrape.ContentEnd
+
+	if _, err := FromHTML(data); !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "boostablebosses.html")
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, _ = w.Write([]byte(data))
+		},
+	))
+	defer srv.Close()
+
+	baseURL := parsers.BaseURL
+	parsers.BaseURL = srv.URL
+	defer func() { parsers.BaseURL = baseURL }()
+
+	p := Parser{}
+
+	boosted, err := p.Parse(context.Background(), Args{}, parsers.Options{})
+	if err != nil {
+		t.Fatalf("failed to parse: %s", err)
+	}
+
+	if boosted != want {
+		t.Errorf("Wrong boosted\nwant: %+v\ngot: %+v", want, boosted)
+	}
+
+	if requests != 1 {
+		t.Errorf("Wrong amount of requests\nwant: %d\ngot: %d", 1, requests)
+	}
+}
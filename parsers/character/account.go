@@ -0,0 +1,165 @@
+package character
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	badgesCaption       = "Account Badges"
+	achievementsCaption = "Account Achievements"
+	charactersCaption   = "Characters"
+
+	badgeIndexer    = `ActivateHelperDiv($(this), '`
+	badgeSeparator  = `', '`
+	endBadgeIndexer = `');`
+
+	gradeIndexer  = "achievement-grade-symbol"
+	secretIndexer = "achievement-secret-symbol"
+
+	mainCharSuffix = " (Main Character)"
+	onlineStatus   = "online"
+	deletedStatus  = "deleted"
+)
+
+func (p *Parser) readAccountInformation(
+	table string,
+) (*tibia.AccountInformation, error) {
+	var (
+		rows    = scrape.LabeledRows(table)
+		account tibia.AccountInformation
+	)
+
+	if created, ok := rows["Created"]; ok {
+		t, err := scrape.DateTime(created)
+		if err != nil {
+			return nil, fmt.Errorf("account created: %w", err)
+		}
+		account.Created = t
+	}
+
+	account.Position = scrape.Text(rows["Position"])
+
+	return &account, nil
+}
+
+// readBadges reads badges, which are displayed as images with a helper div
+// holding their name and description, such as
+//
+//	onMouseOver="ActivateHelperDiv($(this), 'Name', 'Description', '');"
+func (p *Parser) readBadges(table string) []tibia.AccountBadge {
+	var badges []tibia.AccountBadge
+
+	for {
+		idx := strings.Index(table, badgeIndexer)
+		if idx == -1 {
+			return badges
+		}
+		table = table[idx+len(badgeIndexer):]
+
+		end := strings.Index(table, endBadgeIndexer)
+		if end == -1 {
+			return badges
+		}
+
+		fields := strings.Split(table[:end], badgeSeparator)
+		table = table[end:]
+
+		if len(fields) < 2 {
+			continue
+		}
+
+		badge := tibia.AccountBadge{
+			Name:        unescapeJS(fields[0]),
+			Description: unescapeJS(fields[1]),
+		}
+
+		if imgIdx := strings.Index(table, "<img"); imgIdx != -1 {
+			badge.ImageURL, _ = scrape.Attr(table[imgIdx:], "src")
+		}
+
+		badges = append(badges, badge)
+	}
+}
+
+func unescapeJS(s string) string {
+	return scrape.Text(strings.ReplaceAll(s, `\'`, `'`))
+}
+
+func (p *Parser) readAchievements(table string) []tibia.DisplayedAchievement {
+	var achievements []tibia.DisplayedAchievement
+
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		grade := strings.Count(cells[0], gradeIndexer)
+		if grade == 0 {
+			continue
+		}
+
+		achievements = append(achievements, tibia.DisplayedAchievement{
+			Name:   scrape.Text(cells[1]),
+			Grade:  grade,
+			Secret: strings.Contains(cells[1], secretIndexer),
+		})
+	}
+
+	return achievements
+}
+
+// readOtherCharacters reads the characters table, whose rows are such as
+// `1. Bubble (Main Character) | Antica | online | <form>...</form>`.
+func (p *Parser) readOtherCharacters(table string) []tibia.OtherCharacter {
+	var chars []tibia.OtherCharacter
+
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 3 {
+			continue
+		}
+
+		name := scrape.Text(cells[0])
+
+		// Skip the header row.
+		dotIdx := strings.Index(name, ". ")
+		if dotIdx == -1 {
+			continue
+		}
+		name = name[dotIdx+2:]
+
+		char := tibia.OtherCharacter{
+			World: scrape.Text(cells[1]),
+		}
+
+		if strings.HasSuffix(name, mainCharSuffix) {
+			char.Main = true
+			name = strings.TrimSuffix(name, mainCharSuffix)
+		}
+
+		if strings.HasSuffix(name, tradedSuffix) {
+			char.Traded = true
+			name = strings.TrimSuffix(name, tradedSuffix)
+		}
+
+		char.Name = name
+
+		status := strings.ToLower(scrape.Text(cells[2]))
+		if strings.Contains(status, onlineStatus) {
+			char.Status = tibia.OnlineStatusOnline
+		}
+
+		if strings.Contains(status, deletedStatus) {
+			char.Deletion = tibia.DeletionStatusDeleted
+		}
+
+		chars = append(chars, char)
+	}
+
+	return chars
+}
@@ -0,0 +1,354 @@
+// Package character provides an implementation of the Parser interface for
+// parsing information about a character from the tibia.com Characters page.
+//
+// To use the character package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the name of the character to fetch
+// the HTML content from the Characters page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package character
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=characters"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the characters endpoint.
+	contentLength = 50000
+)
+
+var _ parsers.Parser[Args, tibia.Character] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing information about a character from the tibia.com Characters page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Name is the name of the character to be parsed.
+	//
+	// Name must be a valid character name according to the legacy rules, see
+	// tibia.IsCharNameValid. Otherwise, parsers.ErrInvalidArgs is returned.
+	Name string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com reports that the character does not exist, an error wrapping
+// parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Character, error) {
+	if !tibia.IsCharNameValid(args.Name, false) {
+		return tibia.Character{}, fmt.Errorf(
+			"character: invalid name %q: %w", args.Name, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.characterURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Character{}, fmt.Errorf("character: %w", err)
+	}
+
+	if strings.Contains(data, notFoundIndexer) {
+		return tibia.Character{}, fmt.Errorf(
+			"character: %q: %w", args.Name, parsers.ErrNotFound,
+		)
+	}
+
+	char, err := p.parse(data)
+	if err != nil {
+		return tibia.Character{}, fmt.Errorf(
+			"character: failed to parse body: %w", err,
+		)
+	}
+
+	return char, nil
+}
+
+func (p *Parser) characterURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("name", args.Name)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	notFoundIndexer = `>Could not find character</div>`
+
+	infoCaption    = "Character Information"
+	accountCaption = "Account Information"
+
+	tradedSuffix    = " (traded)"
+	deletionIndexer = ", will be deleted at "
+	neverLogged     = "never logged in"
+
+	titlesIndexer = " titles unlocked)"
+	noTitle       = "None"
+
+	houseIDKey      = "houseid"
+	houseTownPrefix = "("
+	houseTownSuffix = ") is paid until "
+
+	guildRankSuffix = " of the"
+)
+
+func (p *Parser) parse(data string) (tibia.Character, error) {
+	var char tibia.Character
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return char, fmt.Errorf("character: %w", err)
+	}
+
+	info, ok := scrape.Table(content, infoCaption)
+	if !ok {
+		return char, fmt.Errorf("character: information table not found")
+	}
+
+	for _, row := range scrape.Rows(info) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		label := strings.TrimSuffix(scrape.Text(cells[0]), ":")
+		if err := p.readInfoRow(&char, label, cells[1]); err != nil {
+			return char, fmt.Errorf("character: %s: %w", label, err)
+		}
+	}
+
+	if char.Name == "" {
+		return char, fmt.Errorf("character: name not found")
+	}
+
+	if account, ok := scrape.Table(content, accountCaption); ok {
+		rows := scrape.LabeledRows(account)
+		char.LoyaltyTitle = scrape.Text(rows["Loyalty Title"])
+
+		char.Account, err = p.readAccountInformation(account)
+		if err != nil {
+			return char, fmt.Errorf("character: %w", err)
+		}
+	}
+
+	if badges, ok := scrape.Table(content, badgesCaption); ok {
+		char.AccountBadges = p.readBadges(badges)
+	}
+
+	if achievements, ok := scrape.Table(content, achievementsCaption); ok {
+		char.Achievements = p.readAchievements(achievements)
+	}
+
+	if chars, ok := scrape.Table(content, charactersCaption); ok {
+		char.OtherCharacters = p.readOtherCharacters(chars)
+	} else {
+		char.AccountVisibility = tibia.VisibilityHidden
+	}
+
+	if deaths, ok := scrape.Table(content, deathsCaption); ok {
+		char.Deaths, err = p.readDeaths(deaths)
+		if err != nil {
+			return char, fmt.Errorf("character: %w", err)
+		}
+	}
+
+	return char, nil
+}
+
+func (p *Parser) readInfoRow(
+	char *tibia.Character,
+	label, value string,
+) error {
+	switch label {
+	case "Name":
+		// The name cell also holds the report link on its right.
+		if idx := strings.Index(value, "<div"); idx != -1 {
+			value = value[:idx]
+		}
+
+		name := scrape.Text(value)
+		if idx := strings.Index(name, deletionIndexer); idx != -1 {
+			t, err := scrape.DateTime(name[idx+len(deletionIndexer):])
+			if err != nil {
+				return fmt.Errorf("deletion time: %w", err)
+			}
+			char.Deletion = tibia.DeletionStatusScheduled
+			char.DeletionTime = t
+			name = name[:idx]
+		}
+
+		if strings.HasSuffix(name, tradedSuffix) {
+			char.Traded = true
+			name = strings.TrimSuffix(name, tradedSuffix)
+		}
+		char.Name = name
+	case "Former Names":
+		for _, name := range strings.Split(scrape.Text(value), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				char.FormerNames = append(char.FormerNames, name)
+			}
+		}
+	case "Title":
+		title, unlocked, err := p.readTitle(scrape.Text(value))
+		if err != nil {
+			return err
+		}
+		char.Title, char.UnlockedTitles = title, unlocked
+	case "Sex":
+		char.Sex = scrape.Text(value)
+	case "Vocation":
+		voc, err := tibia.VocationFromString(scrape.Text(value))
+		if err != nil {
+			return err
+		}
+		char.Vocation = voc
+	case "Level":
+		level, err := scrape.Int(value)
+		if err != nil {
+			return err
+		}
+		char.Level = level
+	case "Achievement Points":
+		points, err := scrape.Int(value)
+		if err != nil {
+			return err
+		}
+		char.AchievementPoints = points
+	case "World":
+		char.World = scrape.Text(value)
+	case "Former World":
+		char.FormerWorld = scrape.Text(value)
+	case "Residence":
+		char.Residence = scrape.Text(value)
+	case "House":
+		house, err := p.readHouse(value)
+		if err != nil {
+			return err
+		}
+		char.Houses = append(char.Houses, house)
+	case "Guild Membership":
+		char.Guild = p.readGuild(value)
+	case "Last Login":
+		text := scrape.Text(value)
+		if text == neverLogged {
+			return nil
+		}
+
+		lastLogin, err := scrape.DateTime(text)
+		if err != nil {
+			return err
+		}
+		char.LastLogin = lastLogin
+	case "Comment":
+		char.Comment = scrape.Text(value)
+	case "Account Status":
+		char.AccountStatus = scrape.Text(value)
+	}
+
+	return nil
+}
+
+// readTitle reads titles such as "Aspiring Huntsman (16 titles unlocked)".
+func (p *Parser) readTitle(text string) (string, int, error) {
+	if !strings.HasSuffix(text, titlesIndexer) {
+		return text, 0, nil
+	}
+
+	text = strings.TrimSuffix(text, titlesIndexer)
+
+	idx := strings.LastIndex(text, " (")
+	if idx == -1 {
+		return "", 0, fmt.Errorf("unlocked titles not found")
+	}
+
+	unlocked, err := strconv.Atoi(text[idx+2:])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid unlocked titles: %w", err)
+	}
+
+	title := text[:idx]
+	if title == noTitle {
+		title = ""
+	}
+
+	return title, unlocked, nil
+}
+
+// readHouse reads houses such as
+// `<a href="...&houseid=10101">Park Lane 1a</a> (Thais) is paid until ...`.
+func (p *Parser) readHouse(value string) (tibia.CharacterHouse, error) {
+	var house tibia.CharacterHouse
+
+	// Attr already unescapes the href, so "&amp;" is not a problem here.
+	if href, ok := scrape.Attr(value, "href"); ok {
+		u, err := url.Parse(href)
+		if err != nil {
+			return house, fmt.Errorf("invalid house link: %w", err)
+		}
+
+		if id := u.Query().Get(houseIDKey); id != "" {
+			house.ID, err = strconv.Atoi(id)
+			if err != nil {
+				return house, fmt.Errorf("invalid house id: %w", err)
+			}
+		}
+	}
+
+	name, rest, ok := scrape.Between(value, ">", "</a>")
+	if !ok {
+		return house, fmt.Errorf("house name not found")
+	}
+	house.Name = scrape.Text(name)
+
+	rest = scrape.Text(rest)
+	town, paidUntil, ok := scrape.Between(rest, houseTownPrefix, houseTownSuffix)
+	if !ok {
+		return house, fmt.Errorf("house town not found")
+	}
+	house.Town = town
+
+	date, err := scrape.Date(paidUntil)
+	if err != nil {
+		return house, err
+	}
+	house.PaidUntil = date
+
+	return house, nil
+}
+
+// readGuild reads guild memberships such as
+// `Vice Leader of the <a href="...">Red Rose</a>`.
+func (p *Parser) readGuild(value string) *tibia.GuildMembership {
+	idx := strings.Index(value, "<a")
+	if idx == -1 {
+		return nil
+	}
+
+	return &tibia.GuildMembership{
+		Name: scrape.Text(value[idx:]),
+		Rank: strings.TrimSuffix(scrape.Text(value[:idx]), guildRankSuffix),
+	}
+}
@@ -0,0 +1,363 @@
+package character
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "character.html")
+
+	p := Parser{}
+
+	char, err := p.parse(data)
+	if err != nil {
+		t.Errorf("failed to parse data: %s\n%#v\n", err, err)
+		return
+	}
+
+	cet := time.FixedZone("CET", 1*60*60)
+	cest := time.FixedZone("CEST", 2*60*60)
+
+	want := tibia.Character{
+		Name:              "Kharsek Valdor",
+		FormerNames:       []string{"Kharsek", "Kharsek the Bold"},
+		Title:             "Aspiring Huntsman",
+		UnlockedTitles:    16,
+		Sex:               "male",
+		Vocation:          tibia.VocationEliteKnight,
+		Level:             1174,
+		AchievementPoints: 1042,
+		World:             "Antica",
+		FormerWorld:       "Bona",
+		Residence:         "Thais",
+		Houses: []tibia.CharacterHouse{
+			{
+				ID:        10101,
+				Name:      "Park Lane 1a",
+				Town:      "Thais",
+				PaidUntil: time.Date(2023, time.July, 20, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		Guild: &tibia.GuildMembership{
+			Name: "Red Rose",
+			Rank: "Vice Leader",
+		},
+		LastLogin:     time.Date(2023, time.July, 5, 14, 33, 12, 0, cest),
+		Comment:       "Hunting partner wanted.\nAsk for \"Kharsek\" in Thais.",
+		AccountStatus: "Premium Account",
+		LoyaltyTitle:  "Sentinel of Tibia",
+		Account: &tibia.AccountInformation{
+			Created:  time.Date(2006, time.January, 2, 19, 42, 55, 0, cet),
+			Position: "Tutor",
+		},
+		AccountBadges: []tibia.AccountBadge{
+			{
+				Name: "Global Player (Gold)",
+				Description: "Summing up the levels of all characters on the " +
+					"account amounts to at least 2000.",
+				ImageURL: "https://static.tibia.com/images/badges/" +
+					"badge_globalplayer_gold.png",
+			},
+			{
+				Name: "Fledegling Hero",
+				Description: "The account is at least 1 year old and has been " +
+					"premium for at least 365 days.",
+				ImageURL: "https://static.tibia.com/images/badges/" +
+					"badge_fledeglinghero.png",
+			},
+			{
+				Name:        "Tibia Insider",
+				Description: "Has been a member of the Tibia Insider's program.",
+				ImageURL: "https://static.tibia.com/images/badges/" +
+					"badge_tibiainsider.png",
+			},
+		},
+		Achievements: []tibia.DisplayedAchievement{
+			{Name: "Allow Cookies?", Grade: 1, Secret: true},
+			{Name: "Ultimate Warlord", Grade: 3},
+			{Name: "Bone Brother", Grade: 2},
+		},
+		AccountVisibility: tibia.VisibilityVisible,
+		OtherCharacters: []tibia.OtherCharacter{
+			{
+				Name:   "Kharsek Valdor",
+				World:  "Antica",
+				Main:   true,
+				Status: tibia.OnlineStatusOnline,
+			},
+			{
+				Name:  "Kharsek Alt",
+				World: "Secura",
+			},
+			{
+				Name:     "Old Kharsek",
+				World:    "Bona",
+				Traded:   true,
+				Deletion: tibia.DeletionStatusDeleted,
+			},
+		},
+	}
+
+	if !char.LastLogin.Equal(want.LastLogin) {
+		t.Errorf(
+			"Wrong last login\nwant: %s\ngot: %s",
+			want.LastLogin, char.LastLogin,
+		)
+	}
+	char.LastLogin = want.LastLogin
+
+	if char.Account != nil && char.Account.Created.Equal(want.Account.Created) {
+		char.Account.Created = want.Account.Created
+	}
+
+	if !reflect.DeepEqual(want, char) {
+		t.Errorf("Wrong character\nwant: %#v\ngot: %#v", want, char)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	data := static.MustRead(t, "character_notfound.html")
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(data))
+		},
+	))
+	defer srv.Close()
+
+	baseURL := parsers.BaseURL
+	parsers.BaseURL = srv.URL
+	defer func() { parsers.BaseURL = baseURL }()
+
+	p := Parser{}
+
+	_, err := p.Parse(
+		context.Background(), Args{Name: "Kharsek Valdor"}, parsers.Options{},
+	)
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidName(t *testing.T) {
+	p := Parser{}
+
+	for _, name := range []string{"", " Kharsek", "a", "Kharsek  Valdor"} {
+		t.Run(name, func(t *testing.T) {
+			_, err := p.Parse(
+				context.Background(), Args{Name: name}, parsers.Options{},
+			)
+			if !errors.Is(err, parsers.ErrInvalidArgs) {
+				t.Errorf(
+					"unexpected error\nwant: %s\ngot: %v",
+					parsers.ErrInvalidArgs, err,
+				)
+			}
+		})
+	}
+}
+
+func TestReadHouse(t *testing.T) {
+	// houseid is not always the last parameter of the link.
+	value := `<a href="https://www.tibia.com/community/?subtopic=houses` +
+		`&amp;houseid=10101&amp;world=Antica&amp;page=view" >Park Lane 1a` +
+		`</a> (Thais) is paid until Jul&#160;20&#160;2023`
+
+	p := Parser{}
+
+	house, err := p.readHouse(value)
+	if err != nil {
+		t.Fatalf("failed to read house: %s", err)
+	}
+
+	want := tibia.CharacterHouse{
+		ID:        10101,
+		Name:      "Park Lane 1a",
+		Town:      "Thais",
+		PaidUntil: time.Date(2023, time.July, 20, 0, 0, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(house, want) {
+		t.Errorf("Wrong house\nwant: %+v\ngot: %+v", want, house)
+	}
+}
+
+func TestParserDeaths(t *testing.T) {
+	data := static.MustRead(t, "character_deaths.html")
+
+	p := Parser{}
+
+	char, err := p.parse(data)
+	if err != nil {
+		t.Errorf("failed to parse data: %s\n%#v\n", err, err)
+		return
+	}
+
+	if char.Name != "Sandy Andersen" {
+		t.Errorf("Wrong name\nwant: %s\ngot: %s", "Sandy Andersen", char.Name)
+	}
+
+	if char.Deletion != tibia.DeletionStatusScheduled {
+		t.Errorf(
+			"Wrong deletion status\nwant: %s\ngot: %s",
+			tibia.DeletionStatusScheduled, char.Deletion,
+		)
+	}
+
+	deletion := time.Date(2023, time.July, 20, 8, 0, 0, 0, time.UTC)
+	if !char.DeletionTime.Equal(deletion) {
+		t.Errorf(
+			"Wrong deletion time\nwant: %s\ngot: %s",
+			deletion, char.DeletionTime,
+		)
+	}
+
+	if char.AccountVisibility != tibia.VisibilityHidden {
+		t.Errorf(
+			"Wrong account visibility\nwant: %s\ngot: %s",
+			tibia.VisibilityHidden, char.AccountVisibility,
+		)
+	}
+
+	if len(char.Achievements) != 0 {
+		t.Errorf(
+			"Wrong achievements\nwant: %d\ngot: %d", 0, len(char.Achievements),
+		)
+	}
+
+	if char.Title != "" || char.UnlockedTitles != 0 {
+		t.Errorf(
+			"Wrong title\nwant: %q (0)\ngot: %q (%d)",
+			"", char.Title, char.UnlockedTitles,
+		)
+	}
+
+	player := func(name string) tibia.Killer {
+		return tibia.Killer{Name: name, Type: tibia.KillerTypePlayer}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		idx     int
+		time    time.Time
+		level   int
+		killers []tibia.Killer
+		assists []tibia.Killer
+	}{
+		{
+			name:  "names containing and",
+			idx:   0,
+			time:  time.Date(2023, time.July, 4, 20, 12, 33, 0, time.UTC),
+			level: 302,
+			killers: []tibia.Killer{
+				player("Rick and Morty"),
+				player("Brandon"),
+				{
+					Name:     "fire elemental",
+					Type:     tibia.KillerTypeSummon,
+					Summoner: "Anders Sand",
+				},
+				{Name: "dragon lord", Type: tibia.KillerTypeMonster},
+			},
+			assists: []tibia.Killer{
+				player("Sandy and Bandy"),
+				player("Nandor"),
+			},
+		},
+		{
+			name:  "trap",
+			idx:   1,
+			time:  time.Date(2023, time.July, 2, 7, 44, 2, 0, time.UTC),
+			level: 301,
+			killers: []tibia.Killer{
+				{Name: "trap", Type: tibia.KillerTypeEnvironment},
+			},
+		},
+		{
+			name:  "field damage",
+			idx:   2,
+			time:  time.Date(2023, time.June, 30, 16, 1, 59, 0, time.UTC),
+			level: 300,
+			killers: []tibia.Killer{
+				{Name: "fire", Type: tibia.KillerTypeEnvironment},
+			},
+		},
+		{
+			name:  "player and field damage",
+			idx:   3,
+			time:  time.Date(2023, time.June, 29, 10, 0, 0, 0, time.UTC),
+			level: 300,
+			killers: []tibia.Killer{
+				player("Brandon"),
+				{Name: "energy", Type: tibia.KillerTypeEnvironment},
+			},
+		},
+		{
+			name:  "boss",
+			idx:   4,
+			time:  time.Date(2023, time.January, 15, 2, 10, 5, 0, time.UTC),
+			level: 250,
+			killers: []tibia.Killer{
+				{Name: "Ferumbras", Type: tibia.KillerTypeMonster},
+			},
+		},
+		{
+			name:  "monster with assist",
+			idx:   5,
+			time:  time.Date(2023, time.January, 14, 22, 59, 59, 0, time.UTC),
+			level: 249,
+			killers: []tibia.Killer{
+				{Name: "orc warlord", Type: tibia.KillerTypeMonster},
+			},
+			assists: []tibia.Killer{
+				player("Nandor"),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(char.Deaths) <= tc.idx {
+				t.Fatalf("Death %d not found, got %d", tc.idx, len(char.Deaths))
+			}
+
+			death := char.Deaths[tc.idx]
+
+			if !death.Time.Equal(tc.time) {
+				t.Errorf("Wrong time\nwant: %s\ngot: %s", tc.time, death.Time)
+			}
+
+			if death.Level != tc.level {
+				t.Errorf("Wrong level\nwant: %d\ngot: %d", tc.level, death.Level)
+			}
+
+			if !reflect.DeepEqual(tc.killers, death.Killers) {
+				t.Errorf(
+					"Wrong killers\nwant: %+v\ngot: %+v", tc.killers, death.Killers,
+				)
+			}
+
+			if !reflect.DeepEqual(tc.assists, death.Assists) {
+				t.Errorf(
+					"Wrong assists\nwant: %+v\ngot: %+v", tc.assists, death.Assists,
+				)
+			}
+		})
+	}
+
+	reason := "Died at Level 249 by an orc warlord. Assisted by Nandor."
+	if char.Deaths[5].Reason != reason {
+		t.Errorf(
+			"Wrong reason\nwant: %s\ngot: %s", reason, char.Deaths[5].Reason,
+		)
+	}
+}
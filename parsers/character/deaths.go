@@ -0,0 +1,207 @@
+package character
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	deathsCaption = "Character Deaths"
+
+	levelIndexer    = " at Level "
+	killersIndexer  = " by "
+	assistsIndexer  = "Assisted by "
+	summonerIndexer = " of "
+
+	// linkPlaceholder replaces player links in death sentences, so player
+	// names that contain separators, i.e. "Rick and Morty", do not break the
+	// split of the killers.
+	linkPlaceholder = "\x00"
+)
+
+// environmentKillers is a set of the killers that are not creatures.
+var environmentKillers = map[string]struct{}{
+	"a trap":           {},
+	"a fire field":     {},
+	"an energy field":  {},
+	"a poison field":   {},
+	"fire":             {},
+	"energy":           {},
+	"earth":            {},
+	"poison":           {},
+	"ice":              {},
+	"holy":             {},
+	"death":            {},
+	"drowning":         {},
+	"bleeding":         {},
+	"life drain":       {},
+	"something evil":   {},
+	"an explosion":     {},
+	"a falling stone":  {},
+	"an electric trap": {},
+}
+
+func (p *Parser) readDeaths(table string) ([]tibia.Death, error) {
+	rows := scrape.Rows(table)
+	deaths := make([]tibia.Death, 0, len(rows))
+	for _, row := range rows {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		death, err := p.readDeath(cells[0], cells[1])
+		if err != nil {
+			return nil, err
+		}
+
+		deaths = append(deaths, death)
+	}
+	return deaths, nil
+}
+
+// readDeath reads a death such as
+// `Killed at Level 300 by <a>Bubble</a> and a dragon lord.<br />Assisted by
+// <a>Cachero</a>.`.
+func (p *Parser) readDeath(timeCell, reasonCell string) (tibia.Death, error) {
+	death := tibia.Death{
+		Reason: strings.ReplaceAll(scrape.Text(reasonCell), "\n", " "),
+	}
+
+	t, err := scrape.DateTime(timeCell)
+	if err != nil {
+		return death, fmt.Errorf("death time: %w", err)
+	}
+	death.Time = t
+
+	reason, names := p.replaceLinks(reasonCell)
+
+	killers, assists, _ := strings.Cut(reason, assistsIndexer)
+
+	levelIdx := strings.Index(killers, levelIndexer)
+	if levelIdx == -1 {
+		return death, fmt.Errorf("death level not found: %q", death.Reason)
+	}
+	killers = killers[levelIdx+len(levelIndexer):]
+
+	level, killers, ok := strings.Cut(killers, killersIndexer)
+	if !ok {
+		return death, fmt.Errorf("death killers not found: %q", death.Reason)
+	}
+
+	death.Level, err = strconv.Atoi(level)
+	if err != nil {
+		return death, fmt.Errorf("invalid death level: %w", err)
+	}
+
+	death.Killers = p.readKillers(killers, &names)
+	death.Assists = p.readKillers(assists, &names)
+
+	return death, nil
+}
+
+// replaceLinks replaces every link in s with linkPlaceholder and returns the
+// text of s along with the text of the replaced links, in order.
+func (p *Parser) replaceLinks(s string) (string, []string) {
+	var (
+		b     strings.Builder
+		names []string
+	)
+
+	for {
+		start := strings.Index(s, "<a ")
+		if start == -1 {
+			break
+		}
+
+		end := strings.Index(s[start:], "</a>")
+		if end == -1 {
+			break
+		}
+		end += start
+
+		b.WriteString(s[:start])
+		b.WriteString(linkPlaceholder)
+		names = append(names, scrape.Text(s[start:end]))
+		s = s[end+len("</a>"):]
+	}
+	b.WriteString(s)
+
+	text := strings.ReplaceAll(scrape.Text(b.String()), "\n", " ")
+	return text, names
+}
+
+// readKillers splits a list of killers such as
+// "\x00, a fire elemental of \x00 and a dragon lord." and classifies each
+// one of them. Player names are taken from names, in order.
+func (p *Parser) readKillers(s string, names *[]string) []tibia.Killer {
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "."))
+	if s == "" {
+		return nil
+	}
+
+	parts := strings.Split(s, ", ")
+	last := parts[len(parts)-1]
+	if idx := strings.LastIndex(last, " and "); idx != -1 {
+		parts = append(parts[:len(parts)-1], last[:idx], last[idx+5:])
+	}
+
+	killers := make([]tibia.Killer, 0, len(parts))
+	for _, part := range parts {
+		killers = append(killers, p.readKiller(part, names))
+	}
+	return killers
+}
+
+func (p *Parser) readKiller(part string, names *[]string) tibia.Killer {
+	nextName := func() string {
+		if len(*names) == 0 {
+			return ""
+		}
+		name := (*names)[0]
+		*names = (*names)[1:]
+		return name
+	}
+
+	if part == linkPlaceholder {
+		return tibia.Killer{
+			Name: nextName(),
+			Type: tibia.KillerTypePlayer,
+		}
+	}
+
+	if strings.HasSuffix(part, summonerIndexer+linkPlaceholder) {
+		return tibia.Killer{
+			Name: trimArticle(
+				strings.TrimSuffix(part, summonerIndexer+linkPlaceholder),
+			),
+			Type:     tibia.KillerTypeSummon,
+			Summoner: nextName(),
+		}
+	}
+
+	if _, ok := environmentKillers[part]; ok {
+		return tibia.Killer{
+			Name: trimArticle(part),
+			Type: tibia.KillerTypeEnvironment,
+		}
+	}
+
+	return tibia.Killer{
+		Name: trimArticle(part),
+		Type: tibia.KillerTypeMonster,
+	}
+}
+
+func trimArticle(name string) string {
+	for _, article := range []string{"a ", "an "} {
+		if strings.HasPrefix(name, article) {
+			return name[len(article):]
+		}
+	}
+	return name
+}
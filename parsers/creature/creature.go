@@ -0,0 +1,262 @@
+// Package creature provides an implementation of the Parser interface for
+// parsing information about a single creature from the tibia.com Creatures
+// Library page.
+//
+// To use the creature package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the race of the creature to fetch
+// the HTML content from the Creatures Library page, parse it, and return the
+// parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// The races of the creatures can be found with the creatures package.
+package creature
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/library/?subtopic=creatures"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the creature endpoint.
+	contentLength = 40000
+)
+
+var _ parsers.Parser[Args, tibia.Creature] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing information about a single creature from the tibia.com Creatures
+// Library page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Race is the identifier tibia.com uses for the creature, i.e.
+	// "dragonlord".
+	//
+	// Race must not be blank. Otherwise, parsers.ErrInvalidArgs is returned.
+	Race string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the creature, which happens when the race
+// does not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Creature, error) {
+	if strings.TrimSpace(args.Race) == "" {
+		return tibia.Creature{}, fmt.Errorf(
+			"creature: invalid race %q: %w", args.Race, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.creatureURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Creature{}, fmt.Errorf("creature: %w", err)
+	}
+
+	creature, err := p.parse(data)
+	if err != nil {
+		return tibia.Creature{}, fmt.Errorf(
+			"creature: failed to parse body: %w", err,
+		)
+	}
+
+	// The race is not part of the creature page.
+	creature.Race = args.Race
+
+	return creature, nil
+}
+
+func (p *Parser) creatureURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("race", args.Race)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	boostedIndexer = `<img id="Monster"`
+	imgIndexer     = "<img "
+
+	hitpointsIndexer    = " have "
+	endHitpointsIndexer = " hitpoints"
+
+	experienceIndexer    = "They yield "
+	endExperienceIndexer = " experience points"
+
+	immuneIndexer = "immune to "
+	strongIndexer = "strong against "
+	weakIndexer   = "weak against "
+	endDamage     = " damage"
+
+	lootIndexer     = "They carry "
+	locationIndexer = "You can find them"
+)
+
+func (p *Parser) parse(data string) (tibia.Creature, error) {
+	var creature tibia.Creature
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return creature, fmt.Errorf("creature: %w", err)
+	}
+
+	// The boosted creature of the page header is part of the content too, so
+	// the creature is only read after its name.
+	headers := scrape.Elements(content, "h2")
+	if len(headers) == 0 {
+		return creature, fmt.Errorf("creature: %w", parsers.ErrNotFound)
+	}
+
+	creature.Name = scrape.Text(headers[0])
+
+	_, page, _ := strings.Cut(content, headers[0])
+
+	if idx := strings.Index(page, imgIndexer); idx != -1 {
+		creature.ImageURL, _ = scrape.Attr(page[idx:], "src")
+	}
+
+	var (
+		desc  []string
+		found bool
+	)
+	for _, paragraph := range scrape.Elements(page, "p") {
+		text := scrape.Text(paragraph)
+		first, _, _ := strings.Cut(text, ". ")
+
+		switch {
+		case strings.HasSuffix(first, endHitpointsIndexer):
+			found = true
+			if err := p.readBehaviour(&creature, text); err != nil {
+				return creature, fmt.Errorf(
+					"creature: %s: %w", creature.Name, err,
+				)
+			}
+		case strings.HasPrefix(text, experienceIndexer):
+			if err := p.readExperience(&creature, text); err != nil {
+				return creature, fmt.Errorf(
+					"creature: %s: %w", creature.Name, err,
+				)
+			}
+		case strings.HasPrefix(text, locationIndexer):
+			creature.Location = text
+		case !found:
+			// Every paragraph before the behaviour is part of the
+			// description.
+			desc = append(desc, text)
+		}
+	}
+
+	if !found {
+		return creature, fmt.Errorf(
+			"creature: %s: hitpoints not found", creature.Name,
+		)
+	}
+
+	creature.Description = strings.Join(desc, "\n")
+	creature.IsBoosted = p.isBoosted(content, creature.ImageURL)
+
+	return creature, nil
+}
+
+// readBehaviour reads paragraphs such as
+// "Dragon Lords have 1,900 hitpoints. They are immune to fire damage and
+// cannot be paralysed or made invisible. Moreover, they are strong against
+// earth damage. On the other hand, they are weak against ice and energy
+// damage. ...".
+func (p *Parser) readBehaviour(creature *tibia.Creature, text string) error {
+	creature.Behaviour = text
+
+	hp, _, _ := scrape.Between(text, hitpointsIndexer, endHitpointsIndexer)
+
+	var err error
+
+	creature.Hitpoints, err = scrape.Int(hp)
+	if err != nil {
+		return fmt.Errorf("hitpoints: %w", err)
+	}
+
+	creature.Immunities = p.readDamageTypes(text, immuneIndexer)
+	creature.Strengths = p.readDamageTypes(text, strongIndexer)
+	creature.Weaknesses = p.readDamageTypes(text, weakIndexer)
+
+	return nil
+}
+
+// readDamageTypes reads the damage types of sentences such as
+// "They are immune to fire, earth and death damage.".
+func (p *Parser) readDamageTypes(text, indexer string) []string {
+	list, _, ok := scrape.Between(text, indexer, endDamage)
+	if !ok {
+		return nil
+	}
+
+	list = strings.ReplaceAll(list, " and ", ", ")
+	return strings.Split(list, ", ")
+}
+
+// readExperience reads paragraphs such as
+// "They yield 2,100 experience points. They carry dragon ham, gold coins and
+// sometimes other items with them.".
+func (p *Parser) readExperience(creature *tibia.Creature, text string) error {
+	exp, rest, ok := scrape.Between(
+		text, experienceIndexer, endExperienceIndexer,
+	)
+	if !ok {
+		return fmt.Errorf("experience not found")
+	}
+
+	var err error
+
+	creature.Experience, err = scrape.Int(exp)
+	if err != nil {
+		return fmt.Errorf("experience: %w", err)
+	}
+
+	if idx := strings.Index(rest, lootIndexer); idx != -1 {
+		creature.Loot = rest[idx:]
+	}
+
+	return nil
+}
+
+// isBoosted reports whether the creature is today's boosted creature.
+//
+// The header displays the singular name of the boosted creature, while the
+// library displays the plural, so the names of their images are compared
+// instead.
+func (p *Parser) isBoosted(content, img string) bool {
+	idx := strings.Index(content, boostedIndexer)
+	if idx == -1 || img == "" {
+		return false
+	}
+
+	boosted, ok := scrape.Attr(content[idx:], "src")
+	if !ok {
+		return false
+	}
+
+	return path.Base(boosted) == path.Base(img)
+}
@@ -0,0 +1,123 @@
+package creature
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	creature, err := p.parse(static.MustRead(t, "creature.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.Creature{
+		Name:     "Dragon Lords",
+		ImageURL: "https://static.tibia.com/images/library/dragonlord.gif",
+		Description: "Dragon lords are the most powerful members of the " +
+			"dragon race.\n" +
+			"Their fiery breath is feared by adventurers all over Tibia.",
+		Hitpoints:  1900,
+		Experience: 2100,
+		Immunities: []string{"fire"},
+		Strengths:  []string{"earth"},
+		Weaknesses: []string{"ice", "energy"},
+		Behaviour: "Dragon Lords have 1,900 hitpoints. They are immune to " +
+			"fire damage and cannot be paralysed or made invisible. " +
+			"Moreover, they are strong against earth damage. On the other " +
+			"hand, they are weak against ice and energy damage. These " +
+			"creatures can neither be summoned nor convinced. In addition, " +
+			"they are able to sense invisible creatures.",
+		Loot: "They carry dragon ham, gold coins, royal helmets and " +
+			"sometimes other items with them.",
+		Location: "You can find them in dragon lairs all over Tibia.",
+	}
+
+	if !reflect.DeepEqual(creature, want) {
+		t.Errorf("Wrong creature\nwant: %+v\ngot: %+v", want, creature)
+	}
+}
+
+func TestParserBoosted(t *testing.T) {
+	p := Parser{}
+
+	creature, err := p.parse(static.MustRead(t, "creature_boosted.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	if !creature.IsBoosted {
+		t.Errorf("%s must be boosted", creature.Name)
+	}
+
+	if creature.Hitpoints != 70 || creature.Experience != 25 {
+		t.Errorf(
+			"Wrong hitpoints and experience\nwant: %d %d\ngot: %d %d",
+			70, 25, creature.Hitpoints, creature.Experience,
+		)
+	}
+
+	wantImmunities := []string{"fire", "earth", "death"}
+	if !reflect.DeepEqual(creature.Immunities, wantImmunities) {
+		t.Errorf(
+			"Wrong immunities\nwant: %v\ngot: %v",
+			wantImmunities, creature.Immunities,
+		)
+	}
+
+	if creature.Strengths != nil {
+		t.Errorf("Wrong strengths\nwant: %v\ngot: %v", nil, creature.Strengths)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "creatures.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{{}, {Race: " "}} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestCreatureURL(t *testing.T) {
+	p := Parser{}
+
+	u, err := url.Parse(p.creatureURL(Args{Race: "dragonlord"}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic": {"creatures"},
+		"race":     {"dragonlord"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
@@ -0,0 +1,119 @@
+// Package creatures provides an implementation of the Parser interface for
+// parsing the list of creatures from the tibia.com Creatures Library page.
+//
+// To use the creatures package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called to fetch the HTML content from the
+// Creatures Library page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// To parse the details of a single creature, see the creature package.
+package creatures
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/library/?subtopic=creatures"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the creatures endpoint.
+	contentLength = 120000
+)
+
+var _ parsers.Parser[Args, []tibia.CreatureOverview] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the list of creatures from the tibia.com Creatures Library page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface, but it is
+// not used by this implementation.
+type Args struct{}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) ([]tibia.CreatureOverview, error) {
+	data, err := fetch.Get(ctx, p.URL(), opts, contentLength)
+	if err != nil {
+		return nil, fmt.Errorf("creatures: %w", err)
+	}
+
+	creatures, err := p.parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("creatures: failed to parse body: %w", err)
+	}
+
+	return creatures, nil
+}
+
+const (
+	creaturesCaption = "Creatures"
+
+	linkIndexer = "<a "
+	imgIndexer  = "<img "
+
+	raceIndexer    = "race="
+	endRaceIndexer = `"`
+)
+
+func (p *Parser) parse(data string) ([]tibia.CreatureOverview, error) {
+	content, err := scrape.Content(data)
+	if err != nil {
+		return nil, fmt.Errorf("creatures: %w", err)
+	}
+
+	table, ok := scrape.Table(content, creaturesCaption)
+	if !ok {
+		return nil, fmt.Errorf("creatures: %w", parsers.ErrNotFound)
+	}
+
+	links := strings.Split(table, linkIndexer)
+
+	creatures := make([]tibia.CreatureOverview, 0, len(links)-1)
+	for _, link := range links[1:] {
+		race, _, ok := scrape.Between(link, raceIndexer, endRaceIndexer)
+		if !ok {
+			continue
+		}
+
+		creature := tibia.CreatureOverview{
+			Race: race,
+		}
+
+		div := scrape.Elements(link, "div")
+		if len(div) == 0 {
+			return nil, fmt.Errorf("creatures: %s: name not found", race)
+		}
+		creature.Name = scrape.Text(div[0])
+
+		imgIdx := strings.Index(link, imgIndexer)
+		if imgIdx == -1 {
+			return nil, fmt.Errorf("creatures: %s: image not found", race)
+		}
+		creature.ImageURL, _ = scrape.Attr(link[imgIdx:], "src")
+
+		creatures = append(creatures, creature)
+	}
+
+	return creatures, nil
+}
@@ -0,0 +1,65 @@
+package creatures
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	creatures, err := p.parse(static.MustRead(t, "creatures.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	const img = "https://static.tibia.com/images/library/"
+
+	want := []tibia.CreatureOverview{
+		{
+			Name:     "Acolytes Of The Cult",
+			Race:     "acolyteofthecult",
+			ImageURL: img + "acolyteofthecult.gif",
+		},
+		{
+			Name:     "Dragon Lords",
+			Race:     "dragonlord",
+			ImageURL: img + "dragonlord.gif",
+		},
+		{
+			Name:     "Dragons",
+			Race:     "dragon",
+			ImageURL: img + "dragon.gif",
+		},
+		{
+			Name:     "Ferumbras",
+			Race:     "ferumbras",
+			ImageURL: img + "ferumbras.gif",
+		},
+		{
+			Name:     "Salamanders",
+			Race:     "salamander",
+			ImageURL: img + "salamander.gif",
+		},
+	}
+
+	if !reflect.DeepEqual(creatures, want) {
+		t.Errorf("Wrong creatures\nwant: %+v\ngot: %+v", want, creatures)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "boostablebosses.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
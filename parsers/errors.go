@@ -18,4 +18,13 @@ var (
 	// ErrUnknownStatusCode will be sent by parsers in case tibia.com responded
 	// with an unknown status code.
 	ErrUnknownStatusCode = errors.New("parsers: unknown status code")
+
+	// ErrInvalidArgs will be sent by parsers in case the args passed to them
+	// are invalid, i.e. an invalid character name. No request is made to
+	// tibia.com in that case.
+	ErrInvalidArgs = errors.New("parsers: invalid args")
+
+	// ErrNotFound will be sent by parsers in case tibia.com reported that the
+	// requested resource, i.e. a character or a world, does not exist.
+	ErrNotFound = errors.New("parsers: not found")
 )
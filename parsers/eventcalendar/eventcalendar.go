@@ -0,0 +1,259 @@
+// Package eventcalendar provides an implementation of the Parser interface for
+// parsing the events of a month from the tibia.com Event Calendar page.
+//
+// To use the eventcalendar package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the desired month to fetch the HTML
+// content from the Event Calendar page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package eventcalendar
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/news/?subtopic=eventcalendar"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the event calendar endpoint.
+	contentLength = 70000
+)
+
+var _ parsers.Parser[Args, tibia.EventCalendar] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the events of a month from the tibia.com Event Calendar page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Year is the year of the calendar to be parsed.
+	//
+	// Year must be greater than 0. Otherwise, parsers.ErrInvalidArgs is
+	// returned.
+	Year int
+
+	// Month is the month of the calendar to be parsed.
+	//
+	// Month must be between time.January and time.December. Otherwise,
+	// parsers.ErrInvalidArgs is returned.
+	Month time.Month
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.EventCalendar, error) {
+	if args.Year <= 0 {
+		return tibia.EventCalendar{}, fmt.Errorf(
+			"eventcalendar: invalid year %d: %w",
+			args.Year, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if args.Month < time.January || args.Month > time.December {
+		return tibia.EventCalendar{}, fmt.Errorf(
+			"eventcalendar: invalid month %d: %w",
+			args.Month, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.calendarURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.EventCalendar{}, fmt.Errorf("eventcalendar: %w", err)
+	}
+
+	calendar, err := p.parse(data, args)
+	if err != nil {
+		return tibia.EventCalendar{}, fmt.Errorf(
+			"eventcalendar: failed to parse body: %w", err,
+		)
+	}
+
+	return calendar, nil
+}
+
+func (p *Parser) calendarURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("calendarmonth", strconv.Itoa(int(args.Month)))
+	vals.Set("calendaryear", strconv.Itoa(args.Year))
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	calendarCaption = "Event Schedule"
+
+	eventIndexer   = `<span class="HelperDivIndicator"`
+	nameIndexer    = "ActivateHelperDiv($(this), '"
+	endNameIndexer = "', '"
+	endDescIndexer = "', '"
+	escapedQuote   = `\'`
+	paragraphEnd   = "</p>"
+	paragraphBreak = "<br/>"
+)
+
+// span is an event being read from the calendar.
+type span struct {
+	name string
+	desc string
+	days []time.Time
+}
+
+func (p *Parser) parse(data string, args Args) (tibia.EventCalendar, error) {
+	calendar := tibia.EventCalendar{
+		Year:  args.Year,
+		Month: args.Month,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return calendar, fmt.Errorf("eventcalendar: %w", err)
+	}
+
+	table, ok := scrape.Table(content, calendarCaption)
+	if !ok {
+		return calendar, fmt.Errorf("eventcalendar: %w", parsers.ErrNotFound)
+	}
+
+	var (
+		day   time.Time
+		spans []*span
+
+		// open holds the spans that were active on the previous day, by
+		// name and description.
+		open = make(map[[2]string]*span)
+	)
+
+	for _, row := range scrape.Rows(table) {
+		for _, cell := range scrape.Cells(row) {
+			n, err := p.readDayNumber(cell)
+			if err != nil {
+				// Header cells, i.e. "Mon".
+				continue
+			}
+
+			if day.IsZero() {
+				day = p.firstDay(n, args)
+			} else {
+				day = day.AddDate(0, 0, 1)
+			}
+
+			if day.Day() != n {
+				return calendar, fmt.Errorf(
+					"eventcalendar: unexpected day %d after %s",
+					n, day.AddDate(0, 0, -1).Format(time.DateOnly),
+				)
+			}
+
+			active := make(map[[2]string]*span)
+			for _, event := range strings.Split(cell, eventIndexer)[1:] {
+				name, desc, err := p.readEvent(event)
+				if err != nil {
+					return calendar, fmt.Errorf(
+						"eventcalendar: %s: %w", day.Format(time.DateOnly), err,
+					)
+				}
+
+				key := [2]string{name, desc}
+
+				s, ok := open[key]
+				if !ok {
+					s = &span{name: name, desc: desc}
+					spans = append(spans, s)
+				}
+
+				s.days = append(s.days, day)
+				active[key] = s
+			}
+
+			open = active
+		}
+	}
+
+	if day.IsZero() {
+		return calendar, fmt.Errorf("eventcalendar: no days found")
+	}
+
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].days[0].Before(spans[j].days[0])
+	})
+
+	calendar.Events = make([]tibia.Event, 0, len(spans))
+	for _, s := range spans {
+		calendar.Events = append(calendar.Events, tibia.Event{
+			Name:        s.name,
+			Description: s.desc,
+			Start:       s.days[0],
+			End:         s.days[len(s.days)-1],
+			Days:        s.days,
+		})
+	}
+
+	return calendar, nil
+}
+
+// readDayNumber reads the number of the day displayed at the top of a cell of
+// the calendar.
+func (p *Parser) readDayNumber(cell string) (int, error) {
+	if idx := strings.Index(cell, eventIndexer); idx != -1 {
+		cell = cell[:idx]
+	}
+	return strconv.Atoi(scrape.Text(cell))
+}
+
+// firstDay returns the date of the first cell of the calendar, which belongs
+// to the previous month unless the month starts on a Monday.
+func (p *Parser) firstDay(n int, args Args) time.Time {
+	month := args.Month
+	if n > 7 {
+		// The first week of the month has 7 days at most, so the cell must
+		// belong to the previous month.
+		month--
+	}
+	return time.Date(args.Year, month, n, 0, 0, 0, 0, time.UTC)
+}
+
+// readEvent reads the name and the description of an event from the script
+// that displays its helper box, such as
+// "ActivateHelperDiv($(this), 'Rapid Respawn', '<p>Creatures respawn
+// faster.</p>', ...".
+func (p *Parser) readEvent(event string) (name, desc string, err error) {
+	name, rest, ok := scrape.Between(event, nameIndexer, endNameIndexer)
+	if !ok {
+		return "", "", fmt.Errorf("event name not found")
+	}
+
+	desc, _, ok = scrape.Between(rest, "", endDescIndexer)
+	if !ok {
+		return "", "", fmt.Errorf("%s: description not found", name)
+	}
+
+	name = scrape.Text(strings.ReplaceAll(name, escapedQuote, "'"))
+
+	desc = strings.ReplaceAll(desc, escapedQuote, "'")
+	desc = scrape.Text(strings.ReplaceAll(desc, paragraphEnd, paragraphBreak))
+
+	return name, desc, nil
+}
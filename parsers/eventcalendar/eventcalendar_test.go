@@ -0,0 +1,141 @@
+package eventcalendar
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func days(month time.Month, from, to int) []time.Time {
+	var days []time.Time
+	for d := from; d <= to; d++ {
+		days = append(days, time.Date(2023, month, d, 0, 0, 0, 0, time.UTC))
+	}
+	return days
+}
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	args := Args{Year: 2023, Month: time.July}
+
+	calendar, err := p.parse(static.MustRead(t, "eventcalendar.html"), args)
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	const (
+		rapidRespawnDesc = "Creatures respawn faster all over the world. " +
+			"Hunters' delight!"
+	)
+
+	want := tibia.EventCalendar{
+		Year:  2023,
+		Month: time.July,
+		Events: []tibia.Event{
+			{
+				Name:        "Rapid Respawn",
+				Description: rapidRespawnDesc,
+				Days: append(
+					days(time.June, 30, 30), days(time.July, 1, 3)...,
+				),
+			},
+			{
+				Name:        "Double XP and Double Skill",
+				Description: "Gain double experience and skill points!",
+				Days:        days(time.July, 7, 10),
+			},
+			{
+				Name: "Lightbearer",
+				Description: "Light the basins all over Tibia & keep the " +
+					"darkness away.",
+				Days: days(time.July, 20, 21),
+			},
+			{
+				Name:        "Rapid Respawn",
+				Description: rapidRespawnDesc,
+				Days: append(
+					days(time.July, 28, 31), days(time.August, 1, 1)...,
+				),
+			},
+		},
+	}
+
+	for i := range want.Events {
+		e := &want.Events[i]
+		e.Start, e.End = e.Days[0], e.Days[len(e.Days)-1]
+	}
+
+	if !reflect.DeepEqual(calendar, want) {
+		t.Errorf("Wrong calendar\nwant: %+v\ngot: %+v", want, calendar)
+	}
+
+	noon := time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC)
+
+	active := calendar.ActiveOn(noon)
+	if len(active) != 1 || active[0].Name != "Rapid Respawn" {
+		t.Errorf("Wrong active events on Jul 01\ngot: %+v", active)
+	}
+
+	if active := calendar.ActiveOn(days(time.July, 4, 4)[0]); len(active) != 0 {
+		t.Errorf("Wrong active events on Jul 04\ngot: %+v", active)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	args := Args{Year: 2023, Month: time.July}
+
+	_, err := p.parse(static.MustRead(t, "latestnews.html"), args)
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{
+		{},
+		{Month: time.July},
+		{Year: 2023},
+		{Year: 2023, Month: 13},
+	} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestCalendarURL(t *testing.T) {
+	p := Parser{}
+
+	u, err := url.Parse(p.calendarURL(Args{Year: 2023, Month: time.July}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic":      {"eventcalendar"},
+		"calendarmonth": {"7"},
+		"calendaryear":  {"2023"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
@@ -0,0 +1,138 @@
+// Package experiencetable provides an implementation of the Parser interface
+// for parsing the tibia.com Experience Table page.
+//
+// To use the experiencetable package, create an instance of the Parser
+// struct, which implements the Parser interface.
+// The Parse method can then be called to fetch the HTML content from the
+// Experience Table page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// The experience of any level can also be calculated without any requests with
+// tibia.ExpForLevel.
+package experiencetable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/library/?subtopic=experiencetable"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the experiencetable endpoint.
+	contentLength = 150000
+)
+
+var _ parsers.Parser[Args, []tibia.ExperienceLevel] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the tibia.com Experience Table page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface, but it is
+// not used by this implementation.
+type Args struct{}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// The returned levels are sorted by level.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) ([]tibia.ExperienceLevel, error) {
+	data, err := fetch.Get(ctx, p.URL(), opts, contentLength)
+	if err != nil {
+		return nil, fmt.Errorf("experiencetable: %w", err)
+	}
+
+	levels, err := p.parse(data)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"experiencetable: failed to parse body: %w", err,
+		)
+	}
+
+	return levels, nil
+}
+
+const (
+	experienceTableCaption = "Experience Table"
+
+	headerIndexer = "<b>"
+)
+
+func (p *Parser) parse(data string) ([]tibia.ExperienceLevel, error) {
+	content, err := scrape.Content(data)
+	if err != nil {
+		return nil, fmt.Errorf("experiencetable: %w", err)
+	}
+
+	table, ok := scrape.Table(content, experienceTableCaption)
+	if !ok {
+		return nil, fmt.Errorf("experiencetable: %w", parsers.ErrNotFound)
+	}
+
+	var levels []tibia.ExperienceLevel
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+
+		// The table is split in columns of level and experience pairs, so
+		// the levels of a row are not sequential.
+		for i := 0; i+1 < len(cells); i += 2 {
+			if strings.Contains(cells[i], headerIndexer) {
+				continue
+			}
+
+			level, err := p.readLevel(cells[i], cells[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("experiencetable: %w", err)
+			}
+
+			levels = append(levels, level)
+		}
+	}
+
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Level < levels[j].Level
+	})
+
+	return levels, nil
+}
+
+func (p *Parser) readLevel(
+	levelCell, expCell string,
+) (tibia.ExperienceLevel, error) {
+	var (
+		level tibia.ExperienceLevel
+		err   error
+	)
+
+	level.Level, err = scrape.Int(levelCell)
+	if err != nil {
+		return level, fmt.Errorf("level %q: %w", scrape.Text(levelCell), err)
+	}
+
+	level.Experience, err = scrape.Int(expCell)
+	if err != nil {
+		return level, fmt.Errorf("level %d experience: %w", level.Level, err)
+	}
+
+	return level, nil
+}
@@ -0,0 +1,103 @@
+package experiencetable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	levels, err := p.parse(static.MustRead(t, "experiencetable.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	if len(levels) != 200 {
+		t.Fatalf("Wrong levels count\nwant: %d\ngot: %d", 200, len(levels))
+	}
+
+	for i, level := range levels {
+		if level.Level != i+1 {
+			t.Fatalf(
+				"Wrong level at %d\nwant: %d\ngot: %d", i, i+1, level.Level,
+			)
+		}
+	}
+
+	want := []tibia.ExperienceLevel{
+		{Level: 1, Experience: 0},
+		{Level: 2, Experience: 100},
+		{Level: 8, Experience: 4200},
+		{Level: 100, Experience: 15694800},
+		{Level: 200, Experience: 129389800},
+	}
+	for _, w := range want {
+		if got := levels[w.Level-1]; got != w {
+			t.Errorf("Wrong level\nwant: %+v\ngot: %+v", w, got)
+		}
+	}
+}
+
+// TestExperienceMath validates the experience functions of the tibia package
+// against the table displayed by tibia.com.
+func TestExperienceMath(t *testing.T) {
+	p := Parser{}
+
+	levels, err := p.parse(static.MustRead(t, "experiencetable.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	for i, level := range levels {
+		if got := tibia.ExpForLevel(level.Level); got != level.Experience {
+			t.Errorf(
+				"Wrong experience for level %d\nwant: %d\ngot: %d",
+				level.Level, level.Experience, got,
+			)
+		}
+
+		if got := tibia.LevelForExp(level.Experience); got != level.Level {
+			t.Errorf(
+				"Wrong level for %d experience\nwant: %d\ngot: %d",
+				level.Experience, level.Level, got,
+			)
+		}
+
+		if i == len(levels)-1 {
+			continue
+		}
+
+		next := levels[i+1]
+
+		if got := tibia.LevelForExp(next.Experience - 1); got != level.Level {
+			t.Errorf(
+				"Wrong level for %d experience\nwant: %d\ngot: %d",
+				next.Experience-1, level.Level, got,
+			)
+		}
+
+		want := next.Experience - level.Experience
+		if got := tibia.ExpToNextLevel(level.Experience); got != want {
+			t.Errorf(
+				"Wrong experience to level %d\nwant: %d\ngot: %d",
+				next.Level, want, got,
+			)
+		}
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "spells.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
@@ -0,0 +1,371 @@
+// Package guild provides an implementation of the Parser interface for
+// parsing information about a single guild, including its members and
+// invited characters, from the tibia.com Guild page.
+//
+// To use the guild package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the name of the guild to fetch the
+// HTML content from the Guild page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package guild
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=guilds&page=view"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the guild endpoint.
+	contentLength = 120000
+)
+
+var _ parsers.Parser[Args, tibia.Guild] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing information about a single guild from the tibia.com Guild page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Name is the name of the guild to be parsed.
+	//
+	// Name must not be empty. Otherwise, parsers.ErrInvalidArgs is returned.
+	Name string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the guild, which happens when the guild does
+// not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Guild, error) {
+	if strings.TrimSpace(args.Name) == "" {
+		return tibia.Guild{}, fmt.Errorf(
+			"guild: invalid name %q: %w", args.Name, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.guildURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Guild{}, fmt.Errorf("guild: %w", err)
+	}
+
+	guild, err := p.parse(data)
+	if err != nil {
+		return tibia.Guild{}, fmt.Errorf(
+			"guild: failed to parse body: %w", err,
+		)
+	}
+
+	// The guild name is not part of the information table.
+	guild.Name = args.Name
+
+	return guild, nil
+}
+
+func (p *Parser) guildURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("GuildName", args.Name)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	infoCaption    = "Guild Information"
+	membersCaption = "Guild Members"
+	invitesCaption = "Invited Characters"
+
+	logoIndexer = "<img "
+
+	foundedIndexer    = "The guild was founded on "
+	endWorldIndexer   = " on "
+	endFoundedIndexer = "."
+
+	activeIndexer           = "It is currently active"
+	openApplicationsIndexer = "open for applications"
+
+	homepageIndexer = "The official homepage is at "
+
+	guildhallIndexer     = "Their home on "
+	guildhallNameIndexer = " is "
+	paidUntilIndexer     = ". The rent is paid until "
+
+	disbandIndexer          = "It will be disbanded on "
+	disbandConditionIndexer = " unless "
+
+	titleIndexer    = " ("
+	endTitleIndexer = ")"
+
+	onlineStatus = "online"
+)
+
+func (p *Parser) parse(data string) (tibia.Guild, error) {
+	var guild tibia.Guild
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return guild, fmt.Errorf("guild: %w", err)
+	}
+
+	info, ok := scrape.Table(content, infoCaption)
+	if !ok {
+		return guild, fmt.Errorf("guild: %w", parsers.ErrNotFound)
+	}
+
+	if err := p.readInfo(&guild, info); err != nil {
+		return guild, fmt.Errorf("guild: %w", err)
+	}
+
+	if members, ok := scrape.Table(content, membersCaption); ok {
+		guild.Ranks, err = p.readRanks(members)
+		if err != nil {
+			return guild, fmt.Errorf("guild: %w", err)
+		}
+	}
+
+	if invites, ok := scrape.Table(content, invitesCaption); ok {
+		guild.Invites, err = p.readInvites(invites)
+		if err != nil {
+			return guild, fmt.Errorf("guild: %w", err)
+		}
+	}
+
+	return guild, nil
+}
+
+// readInfo reads the guild information, which is displayed as free text, i.e.
+//
+//	The guild was founded on Antica on Mar 15 2006.
+//	It is currently active and open for applications.
+//	The official homepage is at redrose.example.com.
+//	Their home on Antica is Rat Alley. The rent is paid until Jul 20 2023.
+//	It will be disbanded on Jul 30 2023 unless one more vice leader is ...
+//
+// Every line before the founding date is part of the guild description.
+func (p *Parser) readInfo(guild *tibia.Guild, info string) error {
+	if _, rest, ok := strings.Cut(info, logoIndexer); ok {
+		if logo, ok := scrape.Attr(logoIndexer+rest, "src"); ok {
+			guild.LogoURL = logo
+		}
+	}
+
+	if _, rest, ok := strings.Cut(info, homepageIndexer); ok {
+		if homepage, ok := scrape.Attr(rest, "href"); ok {
+			guild.Homepage = homepage
+		}
+	}
+
+	text := scrape.Text(info)
+
+	desc, rest, ok := strings.Cut(text, foundedIndexer)
+	if !ok {
+		return fmt.Errorf("founding date not found")
+	}
+	guild.Description = strings.TrimSpace(desc)
+
+	for i, line := range strings.Split(rest, "\n") {
+		if i == 0 {
+			if err := p.readFounded(guild, line); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, activeIndexer):
+			guild.Active = true
+			guild.OpenApplications = strings.Contains(
+				line, openApplicationsIndexer,
+			)
+		case strings.HasPrefix(line, guildhallIndexer):
+			if err := p.readGuildhall(guild, line); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, disbandIndexer):
+			if err := p.readDisband(guild, line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readFounded reads lines such as "Antica on Mar 15 2006.".
+func (p *Parser) readFounded(guild *tibia.Guild, line string) error {
+	world, founded, ok := strings.Cut(line, endWorldIndexer)
+	if !ok {
+		return fmt.Errorf("world not found")
+	}
+	guild.World = world
+
+	t, err := scrape.Date(strings.TrimSuffix(founded, endFoundedIndexer))
+	if err != nil {
+		return fmt.Errorf("founding date: %w", err)
+	}
+	guild.Founded = t
+
+	return nil
+}
+
+// readGuildhall reads lines such as
+// "Their home on Antica is Rat Alley. The rent is paid until Jul 20 2023.".
+func (p *Parser) readGuildhall(guild *tibia.Guild, line string) error {
+	home, paidUntil, ok := strings.Cut(line, paidUntilIndexer)
+	if !ok {
+		return fmt.Errorf("guildhall rent not found")
+	}
+
+	_, name, ok := strings.Cut(home, guildhallNameIndexer)
+	if !ok {
+		return fmt.Errorf("guildhall name not found")
+	}
+
+	t, err := scrape.Date(strings.TrimSuffix(paidUntil, "."))
+	if err != nil {
+		return fmt.Errorf("guildhall rent: %w", err)
+	}
+
+	guild.Guildhall = &tibia.Guildhall{
+		Name:      name,
+		PaidUntil: t,
+	}
+
+	return nil
+}
+
+// readDisband reads lines such as
+// "It will be disbanded on Jul 30 2023 unless one more vice leader is
+// appointed.".
+func (p *Parser) readDisband(guild *tibia.Guild, line string) error {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, disbandIndexer), ".")
+
+	i := strings.Index(line, disbandConditionIndexer)
+	if i == -1 {
+		return fmt.Errorf("disband condition not found")
+	}
+	date, condition := line[:i], line[i+1:]
+
+	t, err := scrape.Date(date)
+	if err != nil {
+		return fmt.Errorf("disband date: %w", err)
+	}
+
+	guild.DisbandDate = t
+	guild.DisbandCondition = condition
+
+	return nil
+}
+
+// readRanks reads the members table. The rank is only displayed on the
+// first member of each rank, so members with an empty rank cell belong to the
+// last seen rank.
+func (p *Parser) readRanks(table string) ([]tibia.GuildRank, error) {
+	var ranks []tibia.GuildRank
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 6 {
+			continue
+		}
+
+		// Skip the header row.
+		if !strings.Contains(cells[1], "<a ") {
+			continue
+		}
+
+		if rank := scrape.Text(cells[0]); rank != "" || len(ranks) == 0 {
+			ranks = append(ranks, tibia.GuildRank{Name: rank})
+		}
+
+		member, err := p.readMember(cells)
+		if err != nil {
+			return nil, err
+		}
+
+		last := &ranks[len(ranks)-1]
+		last.Members = append(last.Members, member)
+	}
+	return ranks, nil
+}
+
+func (p *Parser) readMember(cells []string) (tibia.GuildMember, error) {
+	var member tibia.GuildMember
+
+	name, title, ok := strings.Cut(scrape.Text(cells[1]), titleIndexer)
+	member.Name = name
+	if ok {
+		member.Title = strings.TrimSuffix(title, endTitleIndexer)
+	}
+
+	voc, err := tibia.VocationFromString(scrape.Text(cells[2]))
+	if err != nil {
+		return member, fmt.Errorf("%s vocation: %w", member.Name, err)
+	}
+	member.Vocation = voc
+
+	level, err := scrape.Int(scrape.Text(cells[3]))
+	if err != nil {
+		return member, fmt.Errorf("%s level: %w", member.Name, err)
+	}
+	member.Level = level
+
+	joined, err := scrape.Date(scrape.Text(cells[4]))
+	if err != nil {
+		return member, fmt.Errorf("%s joining date: %w", member.Name, err)
+	}
+	member.Joined = joined
+
+	if scrape.Text(cells[5]) == onlineStatus {
+		member.Status = tibia.OnlineStatusOnline
+	}
+
+	return member, nil
+}
+
+func (p *Parser) readInvites(table string) ([]tibia.GuildInvite, error) {
+	var invites []tibia.GuildInvite
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		// Skip the header row and the "no invited characters" row.
+		if !strings.Contains(cells[0], "<a ") {
+			continue
+		}
+
+		invite := tibia.GuildInvite{
+			Name: scrape.Text(cells[0]),
+		}
+
+		date, err := scrape.Date(scrape.Text(cells[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%s invitation date: %w", invite.Name, err)
+		}
+		invite.Date = date
+
+		invites = append(invites, invite)
+	}
+	return invites, nil
+}
@@ -0,0 +1,191 @@
+package guild
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "guild.html")
+
+	p := Parser{}
+
+	guild, err := p.parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.Guild{
+		World:   "Antica",
+		LogoURL: "https://static.tibia.com/images/guildlogos/Red_Rose.gif",
+		Description: "We are the oldest guild of Antica.\n" +
+			"Recruiting knights & druids.",
+		Founded:          date(2006, time.March, 15),
+		Active:           true,
+		OpenApplications: true,
+		Homepage:         "https://redrose.example.com",
+		Guildhall: &tibia.Guildhall{
+			Name:      "Guildhall of the Red Rose",
+			PaidUntil: date(2023, time.July, 20),
+		},
+		DisbandDate:      date(2023, time.July, 30),
+		DisbandCondition: "unless one more vice leader is appointed",
+		Ranks: []tibia.GuildRank{
+			{
+				Name: "Leader",
+				Members: []tibia.GuildMember{
+					{
+						Name:     "Kharsek Valdor",
+						Title:    "The Bold",
+						Vocation: tibia.VocationEliteKnight,
+						Level:    1174,
+						Joined:   date(2006, time.March, 15),
+						Status:   tibia.OnlineStatusOnline,
+					},
+				},
+			},
+			{
+				Name: "Vice Leader",
+				Members: []tibia.GuildMember{
+					{
+						Name:     "Sandy Andersen",
+						Vocation: tibia.VocationRoyalPaladin,
+						Level:    301,
+						Joined:   date(2010, time.April, 1),
+						Status:   tibia.OnlineStatusOffline,
+					},
+					{
+						Name:     "Flora Greenleaf",
+						Title:    "Healer",
+						Vocation: tibia.VocationElderDruid,
+						Level:    999,
+						Joined:   date(2015, time.January, 10),
+						Status:   tibia.OnlineStatusOnline,
+					},
+				},
+			},
+			{
+				Name: "Member",
+				Members: []tibia.GuildMember{
+					{
+						Name:     "Mage Apprentice",
+						Vocation: tibia.VocationSorcerer,
+						Level:    45,
+						Joined:   date(2023, time.June, 30),
+						Status:   tibia.OnlineStatusOffline,
+					},
+				},
+			},
+		},
+		Invites: []tibia.GuildInvite{
+			{Name: "Torbjörn", Date: date(2023, time.July, 1)},
+			{Name: "Nandor", Date: date(2023, time.July, 3)},
+		},
+	}
+
+	if !reflect.DeepEqual(want, guild) {
+		t.Errorf("Wrong guild\nwant: %+v\ngot: %+v", want, guild)
+	}
+
+	online := guild.OnlineMembers()
+	if len(online) != 2 ||
+		online[0].Name != "Kharsek Valdor" ||
+		online[1].Name != "Flora Greenleaf" {
+		t.Errorf("Wrong online members\ngot: %+v", online)
+	}
+
+	if n := len(guild.Members()); n != 4 {
+		t.Errorf("Wrong amount of members\nwant: %d\ngot: %d", 4, n)
+	}
+}
+
+func TestParserFormation(t *testing.T) {
+	data := static.MustRead(t, "guild_formation.html")
+
+	p := Parser{}
+
+	guild, err := p.parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.Guild{
+		World:            "Bona",
+		LogoURL:          "https://static.tibia.com/images/guildlogos/default_logo.gif",
+		Founded:          date(2023, time.July, 4),
+		DisbandDate:      date(2023, time.July, 7),
+		DisbandCondition: "unless it has at least four vice leaders",
+		Ranks: []tibia.GuildRank{
+			{
+				Name: "Leader",
+				Members: []tibia.GuildMember{
+					{
+						Name:     "Kharsek Valdor",
+						Title:    "The Bold",
+						Vocation: tibia.VocationEliteKnight,
+						Level:    1174,
+						Joined:   date(2006, time.March, 15),
+						Status:   tibia.OnlineStatusOnline,
+					},
+				},
+			},
+			{
+				Name: "Vice Leader",
+				Members: []tibia.GuildMember{
+					{
+						Name:     "Nandor",
+						Vocation: tibia.VocationKnight,
+						Level:    20,
+						Joined:   date(2023, time.July, 4),
+						Status:   tibia.OnlineStatusOffline,
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(want, guild) {
+		t.Errorf("Wrong guild\nwant: %+v\ngot: %+v", want, guild)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	data := static.MustRead(t, "guilds.html")
+
+	p := Parser{}
+
+	if _, err := p.parse(data); !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidName(t *testing.T) {
+	p := Parser{}
+
+	for _, name := range []string{"", " "} {
+		t.Run(name, func(t *testing.T) {
+			_, err := p.Parse(
+				context.Background(), Args{Name: name}, parsers.Options{},
+			)
+			if !errors.Is(err, parsers.ErrInvalidArgs) {
+				t.Errorf(
+					"unexpected error\nwant: %s\ngot: %v",
+					parsers.ErrInvalidArgs, err,
+				)
+			}
+		})
+	}
+}
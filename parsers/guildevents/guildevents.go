@@ -0,0 +1,135 @@
+// Package guildevents provides an implementation of the Parser interface for
+// parsing the event log of a guild from the tibia.com Guild Events page.
+//
+// To use the guildevents package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the name of the guild to fetch the
+// HTML content from the Guild Events page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package guildevents
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=guilds&page=view&action=guildevents"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the guild events endpoint.
+	contentLength = 80000
+)
+
+var _ parsers.Parser[Args, tibia.GuildEvents] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the event log of a guild from the tibia.com Guild Events page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Guild is the name of the guild whose events will be parsed.
+	//
+	// Guild must not be empty. Otherwise, parsers.ErrInvalidArgs is returned.
+	Guild string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the events of the guild, which happens when
+// the guild does not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.GuildEvents, error) {
+	if strings.TrimSpace(args.Guild) == "" {
+		return tibia.GuildEvents{}, fmt.Errorf(
+			"guildevents: invalid guild %q: %w",
+			args.Guild, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.guildEventsURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.GuildEvents{}, fmt.Errorf("guildevents: %w", err)
+	}
+
+	events, err := p.parse(data, args.Guild)
+	if err != nil {
+		return tibia.GuildEvents{}, fmt.Errorf(
+			"guildevents: failed to parse body: %w", err,
+		)
+	}
+
+	return events, nil
+}
+
+func (p *Parser) guildEventsURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("GuildName", args.Guild)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const eventsCaption = "Guild Events"
+
+func (p *Parser) parse(data, guild string) (tibia.GuildEvents, error) {
+	events := tibia.GuildEvents{
+		Guild: guild,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return events, fmt.Errorf("guildevents: %w", err)
+	}
+
+	table, ok := scrape.Table(content, eventsCaption)
+	if !ok {
+		return events, fmt.Errorf(
+			"guildevents: %q: %w", guild, parsers.ErrNotFound,
+		)
+	}
+
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		t, err := scrape.DateTime(cells[0])
+		if err != nil {
+			// Skip the header row.
+			continue
+		}
+
+		event := tibia.GuildEvent{
+			Time:    t,
+			Message: scrape.Text(cells[1]),
+		}
+
+		for _, link := range scrape.Elements(cells[1], "a") {
+			event.Characters = append(event.Characters, scrape.Text(link))
+		}
+
+		events.Events = append(events.Events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,90 @@
+package guildevents
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "guildevents.html")
+
+	p := Parser{}
+
+	events, err := p.parse(data, "Red Rose")
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.GuildEvents{
+		Guild: "Red Rose",
+		Events: []tibia.GuildEvent{
+			{
+				Time:       time.Date(2023, time.July, 4, 19, 15, 2, 0, time.UTC),
+				Message:    "Kharsek Valdor invited Nandor.",
+				Characters: []string{"Kharsek Valdor", "Nandor"},
+			},
+			{
+				Time:       time.Date(2023, time.July, 3, 8, 0, 0, 0, time.UTC),
+				Message:    "Torbjörn left the guild.",
+				Characters: []string{"Torbjörn"},
+			},
+			{
+				Time:    time.Date(2022, time.December, 24, 17, 30, 45, 0, time.UTC),
+				Message: "The guild was renamed from Red Roses to Red Rose.",
+			},
+		},
+	}
+
+	if len(events.Events) != len(want.Events) {
+		t.Fatalf(
+			"Wrong length\nwant: %d\ngot: %d",
+			len(want.Events), len(events.Events),
+		)
+	}
+
+	for i, event := range events.Events {
+		if !event.Time.Equal(want.Events[i].Time) {
+			t.Errorf(
+				"Wrong time\nwant: %s\ngot: %s",
+				want.Events[i].Time, event.Time,
+			)
+		}
+		events.Events[i].Time = want.Events[i].Time
+	}
+
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("Wrong events\nwant: %+v\ngot: %+v", want, events)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	data := static.MustRead(t, "guilds.html")
+
+	p := Parser{}
+
+	if _, err := p.parse(data, "Red Rose"); !errors.Is(
+		err, parsers.ErrNotFound,
+	) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidGuild(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.Parse(context.Background(), Args{}, parsers.Options{})
+	if !errors.Is(err, parsers.ErrInvalidArgs) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrInvalidArgs, err,
+		)
+	}
+}
@@ -0,0 +1,149 @@
+// Package guilds provides an implementation of the Parser interface for
+// parsing the guilds of a world from the tibia.com Guilds page.
+//
+// To use the guilds package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the name of the world to fetch the
+// HTML content from the Guilds page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package guilds
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=guilds"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the guilds endpoint.
+	contentLength = 150000
+)
+
+var _ parsers.Parser[Args, tibia.Guilds] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the guilds of a world from the tibia.com Guilds page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// World is the name of the world whose guilds will be parsed.
+	//
+	// World must be a valid world name, see tibia.IsWorldNameValid.
+	// Otherwise, parsers.ErrInvalidArgs is returned.
+	World string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the guilds of the world, which happens when
+// the world does not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Guilds, error) {
+	if !tibia.IsWorldNameValid(args.World) {
+		return tibia.Guilds{}, fmt.Errorf(
+			"guilds: invalid world %q: %w", args.World, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.guildsURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Guilds{}, fmt.Errorf("guilds: %w", err)
+	}
+
+	guilds, err := p.parse(data, args.World)
+	if err != nil {
+		return tibia.Guilds{}, fmt.Errorf(
+			"guilds: failed to parse body: %w", err,
+		)
+	}
+
+	return guilds, nil
+}
+
+func (p *Parser) guildsURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("world", args.World)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	activeCaption    = "Active Guilds on "
+	formationCaption = "Guilds in Course of Formation on "
+
+	nameIndexer    = "<b>"
+	endNameIndexer = "</b>"
+)
+
+func (p *Parser) parse(data, world string) (tibia.Guilds, error) {
+	guilds := tibia.Guilds{
+		World: world,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return guilds, fmt.Errorf("guilds: %w", err)
+	}
+
+	active, ok := scrape.Table(content, activeCaption+world)
+	if !ok {
+		return guilds, fmt.Errorf(
+			"guilds: %q: %w", world, parsers.ErrNotFound,
+		)
+	}
+	guilds.Active = p.readGuilds(active)
+
+	if formation, ok := scrape.Table(content, formationCaption+world); ok {
+		guilds.Formation = p.readGuilds(formation)
+	}
+
+	return guilds, nil
+}
+
+func (p *Parser) readGuilds(table string) []tibia.GuildOverview {
+	rows := scrape.Rows(table)
+	guilds := make([]tibia.GuildOverview, 0, len(rows))
+	for _, row := range rows {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 {
+			continue
+		}
+
+		// Skip the header row and the "no guild found" row.
+		logo, ok := scrape.Attr(cells[0], "src")
+		if !ok {
+			continue
+		}
+
+		name, desc, ok := scrape.Between(cells[1], nameIndexer, endNameIndexer)
+		if !ok {
+			continue
+		}
+
+		guilds = append(guilds, tibia.GuildOverview{
+			Name:        scrape.Text(name),
+			LogoURL:     logo,
+			Description: scrape.Text(desc),
+		})
+	}
+	return guilds
+}
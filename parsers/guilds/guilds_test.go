@@ -0,0 +1,76 @@
+package guilds
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	f, err := static.TestData.Open("testdata/guilds.html")
+	if err != nil {
+		t.Errorf("failed to open test data: %s\n%#v\n", err, err)
+		return
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+
+	p := Parser{}
+
+	guilds, err := p.parse(string(data), "Antica")
+	if err != nil {
+		t.Errorf("failed to parse data: %s\n%#v\n", err, err)
+		return
+	}
+
+	const logoURL = "https://static.tibia.com/images/guildlogos/"
+
+	want := tibia.Guilds{
+		World: "Antica",
+		Active: []tibia.GuildOverview{
+			{
+				Name:    "Red Rose",
+				LogoURL: logoURL + "Red_Rose.gif",
+				Description: "We are the oldest guild of Antica.\n" +
+					"Recruiting knights & druids.",
+			},
+			{
+				Name:    "Bad Company",
+				LogoURL: logoURL + "Bad_Company.gif",
+			},
+			{
+				Name:        "Los Hermanos",
+				LogoURL:     logoURL + "Los_Hermanos.gif",
+				Description: `Hermandad "latina".`,
+			},
+		},
+		Formation: []tibia.GuildOverview{
+			{
+				Name:        "New Dawn",
+				LogoURL:     logoURL + "default_logo.gif",
+				Description: "Founded today.",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(want, guilds) {
+		t.Errorf("Wrong guilds\nwant: %+v\ngot: %+v", want, guilds)
+	}
+
+	if _, err := p.parse(string(data), "Bona"); !errors.Is(
+		err, parsers.ErrNotFound,
+	) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
@@ -0,0 +1,403 @@
+// Package guildwars provides an implementation of the Parser interface for
+// parsing the active wars and the war history of a guild from the tibia.com
+// Guild Wars page.
+//
+// To use the guildwars package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the name of the guild to fetch the
+// HTML content from the Guild Wars page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package guildwars
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=guilds&page=view&action=guildwars"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the guild wars endpoint.
+	contentLength = 60000
+)
+
+var _ parsers.Parser[Args, tibia.GuildWars] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the wars of a guild from the tibia.com Guild Wars page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Guild is the name of the guild whose wars will be parsed.
+	//
+	// Guild must not be empty. Otherwise, parsers.ErrInvalidArgs is returned.
+	Guild string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the wars of the guild, which happens when the
+// guild does not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.GuildWars, error) {
+	if strings.TrimSpace(args.Guild) == "" {
+		return tibia.GuildWars{}, fmt.Errorf(
+			"guildwars: invalid guild %q: %w",
+			args.Guild, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.guildWarsURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.GuildWars{}, fmt.Errorf("guildwars: %w", err)
+	}
+
+	wars, err := p.parse(data, args.Guild)
+	if err != nil {
+		return tibia.GuildWars{}, fmt.Errorf(
+			"guildwars: failed to parse body: %w", err,
+		)
+	}
+
+	return wars, nil
+}
+
+func (p *Parser) guildWarsURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("GuildName", args.Guild)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	activeCaption  = "Active Wars"
+	historyCaption = "War History"
+
+	activeOpponentIndexer  = " is at war with "
+	activeStartedIndexer   = " since "
+	activeScoreIndexer     = " has "
+	activeEndedIndexer     = "The war will end on "
+	endActiveEndedIndexer  = " or as soon as "
+	activeKillLimitIndexer = " reaches "
+	endKillLimitIndexer    = " kills."
+
+	historyOpponentIndexer  = "The war between "
+	historyStartedIndexer   = " started on "
+	historyEndedIndexer     = " and ended on "
+	historyReasonIndexer    = " because "
+	historyScoreIndexer     = " had "
+	historyKillLimitIndexer = "The kill limit was "
+	historyWinnerIndexer    = " won the war."
+
+	scoreIndexer  = " kills against "
+	feeIndexer    = " paid a fee of "
+	endFeeIndexer = " gold coins"
+
+	scoreSeparator = ", while "
+	feeSeparator   = endFeeIndexer + " and "
+
+	killLimitReason = "the kill limit was reached"
+	durationReason  = "the duration expired"
+	surrenderReason = " surrendered"
+	disbandReason   = " was disbanded"
+)
+
+func (p *Parser) parse(data, guild string) (tibia.GuildWars, error) {
+	// Guild is replaced by the name displayed on the page, which may differ
+	// in case from the requested one, as soon as a war is read.
+	wars := tibia.GuildWars{
+		Guild: guild,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return wars, fmt.Errorf("guildwars: %w", err)
+	}
+
+	active, ok := scrape.Table(content, activeCaption)
+	if !ok {
+		return wars, fmt.Errorf("guildwars: %q: %w", guild, parsers.ErrNotFound)
+	}
+
+	for _, desc := range p.readWarDescriptions(active) {
+		wars.Guild, err = p.readGuild(desc)
+		if err != nil {
+			return wars, fmt.Errorf("guildwars: active war: %w", err)
+		}
+
+		war, err := p.readActiveWar(scrape.Text(desc), wars.Guild)
+		if err != nil {
+			return wars, fmt.Errorf("guildwars: active war: %w", err)
+		}
+		wars.Active = append(wars.Active, war)
+	}
+
+	if history, ok := scrape.Table(content, historyCaption); ok {
+		for _, desc := range p.readWarDescriptions(history) {
+			wars.Guild, err = p.readGuild(desc)
+			if err != nil {
+				return wars, fmt.Errorf("guildwars: past war: %w", err)
+			}
+
+			war, err := p.readPastWar(scrape.Text(desc), wars.Guild)
+			if err != nil {
+				return wars, fmt.Errorf("guildwars: past war: %w", err)
+			}
+			wars.History = append(wars.History, war)
+		}
+	}
+
+	return wars, nil
+}
+
+// readWarDescriptions returns the HTML description of every war in the table.
+// Each war is displayed in a row with the logos of both guilds around its
+// description.
+func (p *Parser) readWarDescriptions(table string) []string {
+	var descs []string
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 3 {
+			// Rows such as "The guild is currently not involved in any war."
+			continue
+		}
+		descs = append(descs, cells[1])
+	}
+	return descs
+}
+
+// readGuild reads the name of the guild whose wars are displayed, which is
+// the first bold name of a war description. The name of the opponent is also
+// bold, but it comes after it.
+func (p *Parser) readGuild(desc string) (string, error) {
+	names := scrape.Elements(desc, "b")
+	if len(names) == 0 {
+		return "", fmt.Errorf("guild not found")
+	}
+	return scrape.Text(scrape.Inner(names[0])), nil
+}
+
+// readActiveWar reads descriptions such as
+//
+//	Red Rose is at war with Bad Company since Jul 01 2023.
+//	Red Rose has 45 kills against Bad Company, while Bad Company has 30 ...
+//	The war will end on Jul 30 2023 or as soon as one of the guilds reaches ...
+//	Red Rose paid a fee of 1,000,000 gold coins and Bad Company paid a fee ...
+func (p *Parser) readActiveWar(text, guild string) (tibia.GuildWar, error) {
+	var (
+		war tibia.GuildWar
+		ok  bool
+		err error
+	)
+
+	war.Opponent, _, ok = scrape.Between(
+		text, guild+activeOpponentIndexer, activeStartedIndexer,
+	)
+	if !ok {
+		return war, fmt.Errorf("opponent not found")
+	}
+
+	war.Started, err = p.readDate(text, activeStartedIndexer, ".")
+	if err != nil {
+		return war, fmt.Errorf("%s start date: %w", war.Opponent, err)
+	}
+
+	war.Ended, err = p.readDate(
+		text, activeEndedIndexer, endActiveEndedIndexer,
+	)
+	if err != nil {
+		return war, fmt.Errorf("%s end date: %w", war.Opponent, err)
+	}
+
+	war.KillLimit, err = p.readInt(
+		text, activeKillLimitIndexer, endKillLimitIndexer,
+	)
+	if err != nil {
+		return war, fmt.Errorf("%s kill limit: %w", war.Opponent, err)
+	}
+
+	if err := p.readScoresAndFees(
+		&war, text, guild, activeScoreIndexer,
+	); err != nil {
+		return war, fmt.Errorf("%s %w", war.Opponent, err)
+	}
+
+	return war, nil
+}
+
+// readPastWar reads descriptions such as
+//
+//	The war between Red Rose and Bad Company started on Jan 01 2023 and ...
+//	Red Rose had 100 kills against Bad Company, while Bad Company had 40 ...
+//	The kill limit was 100.
+//	Red Rose paid a fee of 1,000,000 gold coins and Bad Company paid a fee ...
+//	Red Rose won the war.
+func (p *Parser) readPastWar(text, guild string) (tibia.GuildWar, error) {
+	var (
+		war tibia.GuildWar
+		ok  bool
+		err error
+	)
+
+	war.Opponent, _, ok = scrape.Between(
+		text, historyOpponentIndexer+guild+" and ", historyStartedIndexer,
+	)
+	if !ok {
+		return war, fmt.Errorf("opponent not found")
+	}
+
+	war.Started, err = p.readDate(
+		text, historyStartedIndexer, historyEndedIndexer,
+	)
+	if err != nil {
+		return war, fmt.Errorf("%s start date: %w", war.Opponent, err)
+	}
+
+	ended, _, ok := scrape.Between(text, historyEndedIndexer, ".")
+	if !ok {
+		return war, fmt.Errorf("%s end date not found", war.Opponent)
+	}
+
+	ended, reason, ok := strings.Cut(ended, historyReasonIndexer)
+	if !ok {
+		return war, fmt.Errorf("%s end reason not found", war.Opponent)
+	}
+
+	war.Ended, err = scrape.Date(ended)
+	if err != nil {
+		return war, fmt.Errorf("%s end date: %w", war.Opponent, err)
+	}
+
+	switch {
+	case reason == killLimitReason:
+		war.EndReason = tibia.GuildWarEndReasonKillLimit
+	case reason == durationReason:
+		war.EndReason = tibia.GuildWarEndReasonDuration
+	case strings.HasSuffix(reason, surrenderReason):
+		war.EndReason = tibia.GuildWarEndReasonSurrender
+	case strings.HasSuffix(reason, disbandReason):
+		war.EndReason = tibia.GuildWarEndReasonDisband
+	default:
+		return war, fmt.Errorf(
+			"%s end reason: %q: %w",
+			war.Opponent, reason, tibia.ErrUnknownGuildWarEndReason,
+		)
+	}
+
+	war.KillLimit, err = p.readInt(text, historyKillLimitIndexer, ".")
+	if err != nil {
+		return war, fmt.Errorf("%s kill limit: %w", war.Opponent, err)
+	}
+
+	if err := p.readScoresAndFees(
+		&war, text, guild, historyScoreIndexer,
+	); err != nil {
+		return war, fmt.Errorf("%s %w", war.Opponent, err)
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		if winner, ok := strings.CutSuffix(line, historyWinnerIndexer); ok {
+			war.Winner = winner
+		}
+	}
+
+	return war, nil
+}
+
+// readScoresAndFees reads the scores and fees of both guilds, which are
+// displayed the same way for active and past wars, except for the verb used
+// for the scores.
+//
+// Each value is read from the clause that starts with the name of its guild,
+// i.e. "Red Rose has 45 kills against Bad Company", so a guild whose name
+// ends with the name of the other guild is not mistaken for it.
+func (p *Parser) readScoresAndFees(
+	war *tibia.GuildWar,
+	text, guild, scoreVerb string,
+) error {
+	var scores, fees []string
+	for _, line := range strings.Split(text, "\n") {
+		switch {
+		case strings.Contains(line, scoreIndexer):
+			scores = strings.Split(line, scoreSeparator)
+		case strings.Contains(line, feeIndexer):
+			fees = strings.Split(line, feeSeparator)
+		}
+	}
+
+	var err error
+
+	war.Score, err = p.readClause(scores, guild+scoreVerb, scoreIndexer)
+	if err != nil {
+		return fmt.Errorf("score: %w", err)
+	}
+
+	war.OpponentScore, err = p.readClause(
+		scores, war.Opponent+scoreVerb, scoreIndexer,
+	)
+	if err != nil {
+		return fmt.Errorf("opponent score: %w", err)
+	}
+
+	war.Fee, err = p.readClause(fees, guild+feeIndexer, endFeeIndexer)
+	if err != nil {
+		return fmt.Errorf("fee: %w", err)
+	}
+
+	war.OpponentFee, err = p.readClause(
+		fees, war.Opponent+feeIndexer, endFeeIndexer,
+	)
+	if err != nil {
+		return fmt.Errorf("opponent fee: %w", err)
+	}
+
+	return nil
+}
+
+// readClause reads the int between start and end of the clause that starts
+// with start.
+func (p *Parser) readClause(clauses []string, start, end string) (int, error) {
+	for _, clause := range clauses {
+		if s, ok := strings.CutPrefix(clause, start); ok {
+			s, _, _ = strings.Cut(s, end)
+			return scrape.Int(s)
+		}
+	}
+	return 0, fmt.Errorf("%q not found", strings.TrimSpace(start))
+}
+
+func (p *Parser) readInt(text, start, end string) (int, error) {
+	s, _, ok := scrape.Between(text, start, end)
+	if !ok {
+		return 0, fmt.Errorf("%q not found", strings.TrimSpace(start))
+	}
+	return scrape.Int(s)
+}
+
+func (p *Parser) readDate(text, start, end string) (time.Time, error) {
+	s, _, ok := scrape.Between(text, start, end)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%q not found", strings.TrimSpace(start))
+	}
+	return scrape.Date(s)
+}
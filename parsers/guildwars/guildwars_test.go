@@ -0,0 +1,194 @@
+package guildwars
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "guildwars.html")
+
+	p := Parser{}
+
+	wars, err := p.parse(data, "Red Rose")
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.GuildWars{
+		Guild: "Red Rose",
+		Active: []tibia.GuildWar{
+			{
+				Opponent:      "Bad Company",
+				Score:         45,
+				OpponentScore: 30,
+				KillLimit:     100,
+				Fee:           1000000,
+				OpponentFee:   500000,
+				Started:       date(2023, time.July, 1),
+				Ended:         date(2023, time.July, 30),
+				EndReason:     tibia.GuildWarEndReasonNone,
+			},
+		},
+		History: []tibia.GuildWar{
+			{
+				Opponent:      "Los Hermanos",
+				Score:         100,
+				OpponentScore: 40,
+				KillLimit:     100,
+				Fee:           1000000,
+				Started:       date(2023, time.January, 1),
+				Ended:         date(2023, time.January, 15),
+				EndReason:     tibia.GuildWarEndReasonKillLimit,
+				Winner:        "Red Rose",
+			},
+			{
+				Opponent:      "New Dawn",
+				Score:         12,
+				OpponentScore: 12,
+				KillLimit:     500,
+				Started:       date(2023, time.February, 1),
+				Ended:         date(2023, time.March, 1),
+				EndReason:     tibia.GuildWarEndReasonDuration,
+			},
+			{
+				Opponent:      "Bad Company",
+				Score:         3,
+				OpponentScore: 50,
+				KillLimit:     200,
+				Fee:           100000,
+				OpponentFee:   100000,
+				Started:       date(2023, time.April, 1),
+				Ended:         date(2023, time.April, 3),
+				EndReason:     tibia.GuildWarEndReasonSurrender,
+				Winner:        "Bad Company",
+			},
+			{
+				Opponent:  "Old Guard",
+				Score:     7,
+				KillLimit: 50,
+				Started:   date(2023, time.May, 1),
+				Ended:     date(2023, time.May, 2),
+				EndReason: tibia.GuildWarEndReasonDisband,
+				Winner:    "Red Rose",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(want, wars) {
+		t.Errorf("Wrong wars\nwant: %+v\ngot: %+v", want, wars)
+	}
+}
+
+func TestParserGuildCase(t *testing.T) {
+	data := static.MustRead(t, "guildwars.html")
+
+	p := Parser{}
+
+	// tibia.com finds guilds regardless of the case of GuildName.
+	want, err := p.parse(data, "Red Rose")
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	for _, guild := range []string{"red rose", "RED ROSE"} {
+		wars, err := p.parse(data, guild)
+		if err != nil {
+			t.Fatalf("failed to parse data for %q: %s", guild, err)
+		}
+
+		if !reflect.DeepEqual(wars, want) {
+			t.Errorf(
+				"Wrong wars for %q\nwant: %+v\ngot: %+v", guild, want, wars,
+			)
+		}
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	data := static.MustRead(t, "guilds.html")
+
+	p := Parser{}
+
+	if _, err := p.parse(data, "Red Rose"); !errors.Is(
+		err, parsers.ErrNotFound,
+	) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidGuild(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.Parse(context.Background(), Args{}, parsers.Options{})
+	if !errors.Is(err, parsers.ErrInvalidArgs) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrInvalidArgs, err,
+		)
+	}
+}
+
+func TestParserSuffixedGuildNames(t *testing.T) {
+	// The rest of the description is the same for both guilds, only the first
+	// sentence is written from the point of view of the guild being parsed.
+	const details = "Bad Company has 45 kills against Company, " +
+		"while Company has 30 kills against Bad Company.\n" +
+		"The war will end on Jul 30 2023 or as soon as one of the guilds " +
+		"reaches 100 kills.\n" +
+		"Bad Company paid a fee of 1,000 gold coins " +
+		"and Company paid a fee of 500 gold coins."
+
+	p := Parser{}
+
+	for _, tc := range []struct {
+		guild, opponent string
+		want            [4]int
+	}{
+		{
+			guild:    "Bad Company",
+			opponent: "Company",
+			want:     [4]int{45, 30, 1000, 500},
+		},
+		{
+			guild:    "Company",
+			opponent: "Bad Company",
+			want:     [4]int{30, 45, 500, 1000},
+		},
+	} {
+		text := tc.guild + " is at war with " + tc.opponent +
+			" since Jul 01 2023.\n" + details
+
+		war, err := p.readActiveWar(text, tc.guild)
+		if err != nil {
+			t.Fatalf("failed to read war of %s: %s", tc.guild, err)
+		}
+
+		if war.Opponent != tc.opponent {
+			t.Errorf(
+				"Wrong opponent of %s\nwant: %s\ngot: %s",
+				tc.guild, tc.opponent, war.Opponent,
+			)
+		}
+
+		got := [4]int{war.Score, war.OpponentScore, war.Fee, war.OpponentFee}
+		if got != tc.want {
+			t.Errorf(
+				"Wrong scores and fees of %s\nwant: %v\ngot: %v",
+				tc.guild, tc.want, got,
+			)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+// Package highscores provides an implementation of the Parser interface for
+// parsing a page of a highscore list from the tibia.com Highscores page.
+//
+// To use the highscores package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the desired filters to fetch the
+// HTML content from the Highscores page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package highscores
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=highscores"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the highscores endpoint.
+	contentLength = 100000
+
+	// entriesPerPage is the amount of entries displayed on each page.
+	entriesPerPage = 50
+)
+
+var _ parsers.Parser[Args, tibia.Highscores] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing a page of a highscore list from the tibia.com Highscores page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// World is the name of the world to filter by.
+	//
+	// If World is empty, the highscores of all worlds are parsed. Otherwise,
+	// it must be a valid world name, see tibia.IsWorldNameValid, or
+	// parsers.ErrInvalidArgs is returned.
+	World string
+
+	// Category is the highscore category to be parsed.
+	Category tibia.HighscoreCategory
+
+	// Vocation is the vocation to filter by.
+	//
+	// Promoted vocations are filtered the same way as their base vocation.
+	Vocation tibia.Vocation
+
+	// BattleEye is the Battle Eye status of the worlds to filter by.
+	//
+	// If BattleEye is nil, worlds with any Battle Eye status are included.
+	BattleEye *tibia.BattleEyeStatus
+
+	// PvPTypes are the PvP types of the worlds to filter by.
+	//
+	// If PvPTypes is empty, worlds of every PvP type are included.
+	PvPTypes []tibia.PvPType
+
+	// Page is the page to be parsed, starting at 1.
+	//
+	// If Page is 0, the first page is parsed. Page must not be greater than
+	// tibia.MaxHighscoresPage. Otherwise, parsers.ErrInvalidArgs is returned.
+	Page int
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Highscores, error) {
+	if args.World != "" && !tibia.IsWorldNameValid(args.World) {
+		return tibia.Highscores{}, fmt.Errorf(
+			"highscores: invalid world %q: %w",
+			args.World, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if args.Page < 0 || args.Page > tibia.MaxHighscoresPage {
+		return tibia.Highscores{}, fmt.Errorf(
+			"highscores: invalid page %d: %w",
+			args.Page, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if args.Page == 0 {
+		args.Page = 1
+	}
+
+	data, err := fetch.Get(ctx, p.highscoresURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Highscores{}, fmt.Errorf("highscores: %w", err)
+	}
+
+	highscores, err := p.parse(data, args)
+	if err != nil {
+		return tibia.Highscores{}, fmt.Errorf(
+			"highscores: failed to parse body: %w", err,
+		)
+	}
+
+	return highscores, nil
+}
+
+func (p *Parser) highscoresURL(args Args) string {
+	battleEye := tibia.BattleEyeStatusAnyWorld
+	if args.BattleEye != nil {
+		battleEye = *args.BattleEye
+	}
+
+	vals := url.Values{}
+	vals.Set("world", args.World)
+	vals.Set(args.Category.QueryKey(), args.Category.QueryVal())
+	vals.Set(args.Vocation.QueryKey(), args.Vocation.QueryVal())
+	vals.Set(battleEye.QueryKey(), battleEye.QueryVal())
+	for _, pvpType := range args.PvPTypes {
+		vals.Add(pvpType.QueryKey(), pvpType.QueryVal())
+	}
+	vals.Set("currentpage", strconv.Itoa(args.Page))
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	highscoresCaption = "Highscores"
+
+	resultsIndexer    = "Results: "
+	endResultsIndexer = "<"
+
+	rankColumn     = "Rank"
+	nameColumn     = "Name"
+	titleColumn    = "Title"
+	vocationColumn = "Vocation"
+	worldColumn    = "World"
+	levelColumn    = "Level"
+)
+
+func (p *Parser) parse(data string, args Args) (tibia.Highscores, error) {
+	highscores := tibia.Highscores{
+		World:    args.World,
+		Category: args.Category,
+		Vocation: args.Vocation,
+		Page:     args.Page,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return highscores, fmt.Errorf("highscores: %w", err)
+	}
+
+	table, ok := scrape.Table(content, highscoresCaption)
+	if !ok {
+		return highscores, fmt.Errorf("highscores: table not found")
+	}
+
+	highscores.Entries, err = p.readEntries(table)
+	if err != nil {
+		return highscores, fmt.Errorf("highscores: %w", err)
+	}
+
+	results, _, ok := scrape.Between(
+		content, resultsIndexer, endResultsIndexer,
+	)
+	if !ok {
+		return highscores, fmt.Errorf("highscores: results not found")
+	}
+
+	highscores.TotalResults, err = scrape.Int(results)
+	if err != nil {
+		return highscores, fmt.Errorf("highscores: results: %w", err)
+	}
+
+	highscores.TotalPages = (highscores.TotalResults + entriesPerPage - 1) /
+		entriesPerPage
+
+	return highscores, nil
+}
+
+// readEntries reads the entries of the highscores table. The columns depend
+// on the category, i.e. the Loyalty Points category has an additional Title
+// column, so they are looked up by the labels of the header row. The last
+// column always holds the value of the category.
+func (p *Parser) readEntries(table string) ([]tibia.HighscoreEntry, error) {
+	var (
+		columns map[string]int
+		entries []tibia.HighscoreEntry
+	)
+
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+
+		if columns == nil {
+			columns = make(map[string]int, len(cells))
+			for i, cell := range cells {
+				columns[scrape.Text(cell)] = i
+			}
+			continue
+		}
+
+		// Skip rows such as "No character found.".
+		if len(cells) != len(columns) {
+			continue
+		}
+
+		entry, err := p.readEntry(cells, columns)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (p *Parser) readEntry(
+	cells []string,
+	columns map[string]int,
+) (tibia.HighscoreEntry, error) {
+	text := func(column string) string {
+		i, ok := columns[column]
+		if !ok {
+			return ""
+		}
+		return scrape.Text(cells[i])
+	}
+
+	entry := tibia.HighscoreEntry{
+		Name:         text(nameColumn),
+		World:        text(worldColumn),
+		LoyaltyTitle: text(titleColumn),
+	}
+
+	var err error
+
+	entry.Rank, err = scrape.Int(text(rankColumn))
+	if err != nil {
+		return entry, fmt.Errorf("%s rank: %w", entry.Name, err)
+	}
+
+	entry.Vocation, err = tibia.VocationFromString(text(vocationColumn))
+	if err != nil {
+		return entry, fmt.Errorf("%s vocation: %w", entry.Name, err)
+	}
+
+	entry.Level, err = scrape.Int(text(levelColumn))
+	if err != nil {
+		return entry, fmt.Errorf("%s level: %w", entry.Name, err)
+	}
+
+	entry.Value, err = scrape.Int(scrape.Text(cells[len(cells)-1]))
+	if err != nil {
+		return entry, fmt.Errorf("%s value: %w", entry.Name, err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,181 @@
+package highscores
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "highscores.html")
+
+	p := Parser{}
+
+	highscores, err := p.parse(data, Args{
+		World:    "Antica",
+		Category: tibia.HighscoreCategoryExperiencePoints,
+		Page:     1,
+	})
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.Highscores{
+		World:        "Antica",
+		Category:     tibia.HighscoreCategoryExperiencePoints,
+		Vocation:     tibia.VocationAll,
+		Page:         1,
+		TotalPages:   20,
+		TotalResults: 1000,
+		Entries: []tibia.HighscoreEntry{
+			{
+				Rank:     1,
+				Name:     "Kharsek Valdor",
+				Vocation: tibia.VocationEliteKnight,
+				World:    "Antica",
+				Level:    1174,
+				Value:    26915108424,
+			},
+			{
+				Rank:     2,
+				Name:     "Flora Greenleaf",
+				Vocation: tibia.VocationElderDruid,
+				World:    "Antica",
+				Level:    999,
+				Value:    16567311800,
+			},
+			{
+				Rank:     3,
+				Name:     "Sandy Andersen",
+				Vocation: tibia.VocationRoyalPaladin,
+				World:    "Antica",
+				Level:    301,
+				Value:    447235100,
+			},
+			{
+				Rank:     4,
+				Name:     "Torbjörn",
+				Vocation: tibia.VocationMasterSorcerer,
+				World:    "Antica",
+				Level:    250,
+				Value:    254874000,
+			},
+			{
+				Rank:     5,
+				Name:     "Rookie Nobody",
+				Vocation: tibia.VocationNone,
+				World:    "Antica",
+				Level:    8,
+				Value:    4200,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(want, highscores) {
+		t.Errorf("Wrong highscores\nwant: %+v\ngot: %+v", want, highscores)
+	}
+}
+
+func TestParserLoyalty(t *testing.T) {
+	data := static.MustRead(t, "highscores_loyalty.html")
+
+	p := Parser{}
+
+	highscores, err := p.parse(data, Args{
+		Category: tibia.HighscoreCategoryLoyaltyPoints,
+		Page:     2,
+	})
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.Highscores{
+		Category:     tibia.HighscoreCategoryLoyaltyPoints,
+		Vocation:     tibia.VocationAll,
+		Page:         2,
+		TotalPages:   2,
+		TotalResults: 51,
+		Entries: []tibia.HighscoreEntry{
+			{
+				Rank:         50,
+				Name:         "Kharsek Valdor",
+				Vocation:     tibia.VocationEliteKnight,
+				World:        "Antica",
+				Level:        1174,
+				Value:        5400,
+				LoyaltyTitle: "Guardian of Tibia",
+			},
+			{
+				Rank:         51,
+				Name:         "Nandor",
+				Vocation:     tibia.VocationKnight,
+				World:        "Bona",
+				Level:        20,
+				Value:        3650,
+				LoyaltyTitle: "Warden of Tibia",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(want, highscores) {
+		t.Errorf("Wrong highscores\nwant: %+v\ngot: %+v", want, highscores)
+	}
+}
+
+func TestHighscoresURL(t *testing.T) {
+	p := Parser{}
+
+	be := tibia.BattleEyeStatusInitiallyProtected
+
+	u, err := url.Parse(p.highscoresURL(Args{
+		World:     "Antica",
+		Category:  tibia.HighscoreCategoryMagicLevel,
+		Vocation:  tibia.VocationElderDruid,
+		BattleEye: &be,
+		PvPTypes: []tibia.PvPType{
+			tibia.PvPTypeOpenPvP, tibia.PvPTypeRetroOpenPvP,
+		},
+		Page: 3,
+	}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic":     {"highscores"},
+		"world":        {"Antica"},
+		"category":     {"11"},
+		"profession":   {"5"},
+		"beprotection": {"2"},
+		"PvPTypes[]":   {"0", "3"},
+		"currentpage":  {"3"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{
+		{World: "a"},
+		{Page: -1},
+		{Page: tibia.MaxHighscoresPage + 1},
+	} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package highscores
+
+import (
+	"context"
+
+	"github.com/phenpessoa/tibia-crawler/internal/pager"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// PageError is returned by Iterator when a page of the highscore list could
+// not be parsed.
+//
+// The crawl can be resumed from the failed page by creating a new Iterator
+// with Args.Page set to Page.
+type PageError = pager.PageError
+
+// Iterator walks all the pages of a highscore list, starting at Args.Page,
+// until the last page is reached.
+//
+// Pages are only fetched once all the entries of the previous page were
+// consumed, and every request goes through the same ctx and Options, so
+// cancelling ctx stops the crawl and the RateLimiter is honored between pages.
+//
+// Example usage:
+//
+//	it := highscores.NewIterator(ctx, args, opts)
+//	for it.Next() {
+//		entry := it.Entry()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		var pageErr *highscores.PageError
+//		if errors.As(err, &pageErr) {
+//			// Resume later with args.Page = pageErr.Page.
+//		}
+//	}
+type Iterator struct {
+	pager *pager.Iterator[tibia.HighscoreEntry]
+}
+
+// NewIterator returns an Iterator for the highscore list described by args.
+//
+// args.Page is the first page to be fetched. If it is 0, the crawl starts at
+// the first page.
+func NewIterator(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) *Iterator {
+	var p Parser
+
+	fetch := func(page int) ([]tibia.HighscoreEntry, bool, error) {
+		args.Page = page
+
+		highscores, err := p.Parse(ctx, args, opts)
+		if err != nil {
+			return nil, false, err
+		}
+
+		last := page >= highscores.TotalPages ||
+			page >= tibia.MaxHighscoresPage
+		return highscores.Entries, last, nil
+	}
+
+	return &Iterator{
+		pager: pager.New("highscores", args.Page, fetch),
+	}
+}
+
+// Next advances the iterator to the next entry, fetching the next page if
+// needed. It returns false when there are no more entries or an error
+// happened, in which case Err reports it.
+func (it *Iterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Entry returns the current entry.
+//
+// Entry must only be called after a call to Next returned true.
+func (it *Iterator) Entry() tibia.HighscoreEntry {
+	return it.pager.Item()
+}
+
+// Page returns the page the current entry is from.
+func (it *Iterator) Page() int {
+	return it.pager.Page()
+}
+
+// Err returns the error that stopped the iterator, if any.
+//
+// Errors returned while fetching or parsing a page are wrapped in a
+// *PageError.
+func (it *Iterator) Err() error {
+	return it.pager.Err()
+}
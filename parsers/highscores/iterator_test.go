@@ -0,0 +1,165 @@
+package highscores
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// newTestServer serves the loyalty highscores, which have two pages, and
+// fails every page in fail.
+func newTestServer(t *testing.T, fail map[string]bool) *[]string {
+	t.Helper()
+
+	data := static.MustRead(t, "highscores_loyalty.html")
+
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			page := r.URL.Query().Get("currentpage")
+			pages = append(pages, page)
+			if fail[page] {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write([]byte(data))
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	baseURL := parsers.BaseURL
+	parsers.BaseURL = srv.URL
+	t.Cleanup(func() { parsers.BaseURL = baseURL })
+
+	return &pages
+}
+
+func TestIterator(t *testing.T) {
+	pages := newTestServer(t, nil)
+
+	it := NewIterator(context.Background(), Args{
+		Category: tibia.HighscoreCategoryLoyaltyPoints,
+	}, parsers.Options{})
+
+	var (
+		names     []string
+		pageNums  []int
+		wantNames = []string{
+			"Kharsek Valdor", "Nandor", "Kharsek Valdor", "Nandor",
+		}
+		wantPages = []int{1, 1, 2, 2}
+	)
+	for it.Next() {
+		names = append(names, it.Entry().Name)
+		pageNums = append(pageNums, it.Page())
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(names) != len(wantNames) {
+		t.Fatalf("Wrong entries\nwant: %v\ngot: %v", wantNames, names)
+	}
+
+	for i := range names {
+		if names[i] != wantNames[i] || pageNums[i] != wantPages[i] {
+			t.Errorf(
+				"Wrong entry %d\nwant: %s (page %d)\ngot: %s (page %d)",
+				i, wantNames[i], wantPages[i], names[i], pageNums[i],
+			)
+		}
+	}
+
+	if len(*pages) != 2 {
+		t.Errorf(
+			"Wrong amount of requests\nwant: %d\ngot: %d", 2, len(*pages),
+		)
+	}
+}
+
+func TestIteratorPageError(t *testing.T) {
+	newTestServer(t, map[string]bool{"2": true})
+
+	args := Args{Category: tibia.HighscoreCategoryLoyaltyPoints}
+
+	it := NewIterator(context.Background(), args, parsers.Options{})
+
+	var n int
+	for it.Next() {
+		n++
+	}
+
+	if n != 2 {
+		t.Errorf("Wrong amount of entries\nwant: %d\ngot: %d", 2, n)
+	}
+
+	var pageErr *PageError
+	if !errors.As(it.Err(), &pageErr) {
+		t.Fatalf("unexpected error\nwant: *PageError\ngot: %v", it.Err())
+	}
+
+	if pageErr.Page != 2 {
+		t.Errorf("Wrong page\nwant: %d\ngot: %d", 2, pageErr.Page)
+	}
+
+	if !errors.Is(it.Err(), parsers.ErrUnknownStatusCode) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v",
+			parsers.ErrUnknownStatusCode, it.Err(),
+		)
+	}
+
+	if it.Next() {
+		t.Errorf("Next must return false after an error")
+	}
+
+	// Resume from the failed page.
+	newTestServer(t, nil)
+
+	args.Page = pageErr.Page
+	it = NewIterator(context.Background(), args, parsers.Options{})
+
+	n = 0
+	for it.Next() {
+		n++
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if n != 2 {
+		t.Errorf("Wrong amount of entries\nwant: %d\ngot: %d", 2, n)
+	}
+}
+
+func TestIteratorCtxDone(t *testing.T) {
+	pages := newTestServer(t, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := NewIterator(ctx, Args{}, parsers.Options{})
+	if it.Next() {
+		t.Errorf("Next must return false when ctx is done")
+	}
+
+	if !errors.Is(it.Err(), parsers.ErrCtxDone) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrCtxDone, it.Err(),
+		)
+	}
+
+	if len(*pages) != 0 {
+		t.Errorf(
+			"Wrong amount of requests\nwant: %d\ngot: %d", 0, len(*pages),
+		)
+	}
+}
@@ -0,0 +1,330 @@
+// Package house provides an implementation of the Parser interface for
+// parsing information about a single house, including its owner and auction,
+// from the tibia.com House page.
+//
+// To use the house package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the world and the ID of the house
+// to fetch the HTML content from the House page, parse it, and return the
+// parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package house
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=houses&page=view"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the house endpoint.
+	contentLength = 40000
+)
+
+var _ parsers.Parser[Args, tibia.House] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing information about a single house from the tibia.com House page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// World is the name of the world the house is on.
+	//
+	// World must be a valid world name, see tibia.IsWorldNameValid.
+	// Otherwise, parsers.ErrInvalidArgs is returned.
+	World string
+
+	// ID is the ID of the house to be parsed.
+	//
+	// ID must be greater than 0. Otherwise, parsers.ErrInvalidArgs is
+	// returned.
+	ID int
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the house, which happens when the house does
+// not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.House, error) {
+	if !tibia.IsWorldNameValid(args.World) {
+		return tibia.House{}, fmt.Errorf(
+			"house: invalid world %q: %w", args.World, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if args.ID <= 0 {
+		return tibia.House{}, fmt.Errorf(
+			"house: invalid id %d: %w", args.ID, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.houseURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.House{}, fmt.Errorf("house: %w", err)
+	}
+
+	house, err := p.parse(data)
+	if err != nil {
+		return tibia.House{}, fmt.Errorf(
+			"house: failed to parse body: %w", err,
+		)
+	}
+
+	// The ID and the world are not part of the information table.
+	house.ID = args.ID
+	house.World = args.World
+
+	return house, nil
+}
+
+func (p *Parser) houseURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("world", args.World)
+	vals.Set("houseid", strconv.Itoa(args.ID))
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	infoCaption = "House Information"
+
+	bedsIndexer    = "This house has "
+	endBedsIndexer = " bed"
+
+	sizeIndexer    = "The house has a size of "
+	endSizeIndexer = " square meters"
+	rentIndexer    = "The monthly rent is "
+	endRentIndexer = " gold"
+
+	ownerIndexer     = "The house has been rented by "
+	endOwnerIndexer  = ". "
+	paidUntilIndexer = " has paid the rent until "
+
+	moveOutIndexer     = " will move out on "
+	endMoveOutIndexer  = " (time of daily server save)"
+	newOwnerIndexer    = " will pass the house to "
+	endNewOwnerIndexer = " for "
+	endPriceIndexer    = " gold coins"
+
+	auctionIndexer       = "The house is currently being auctioned."
+	auctionEndIndexer    = "The auction will end at "
+	endAuctionEndIndexer = ". "
+	highestBidIndexer    = "The highest bid so far is "
+	endHighestBidIndexer = " gold"
+	bidderIndexer        = " has been submitted by "
+)
+
+func (p *Parser) parse(data string) (tibia.House, error) {
+	var house tibia.House
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return house, fmt.Errorf("house: %w", err)
+	}
+
+	table, ok := scrape.Table(content, infoCaption)
+	if !ok {
+		return house, fmt.Errorf("house: %w", parsers.ErrNotFound)
+	}
+
+	rows := scrape.Rows(table)
+	if len(rows) == 0 {
+		return house, fmt.Errorf("house: information not found")
+	}
+
+	cells := scrape.Cells(rows[0])
+	if len(cells) < 2 {
+		return house, fmt.Errorf("house: information not found")
+	}
+
+	if img, ok := scrape.Attr(cells[0], "src"); ok {
+		house.ImageURL = img
+	}
+
+	lines := strings.Split(scrape.Text(cells[1]), "\n")
+	house.Name = lines[0]
+
+	var desc []string
+	for _, line := range lines[1:] {
+		var err error
+
+		switch {
+		case strings.HasPrefix(line, bedsIndexer):
+			house.Beds, err = p.readInt(line, bedsIndexer, endBedsIndexer)
+		case strings.HasPrefix(line, sizeIndexer):
+			err = p.readSizeAndRent(&house, line)
+		case strings.HasPrefix(line, ownerIndexer):
+			err = p.readOwner(&house, line)
+		case strings.Contains(line, moveOutIndexer):
+			err = p.readTransfer(&house, line)
+		case strings.HasPrefix(line, auctionIndexer):
+			err = p.readAuction(&house, line)
+		case house.Beds == 0:
+			// Every line before the amount of beds is part of the
+			// description.
+			desc = append(desc, line)
+		}
+
+		if err != nil {
+			return house, fmt.Errorf("house: %s: %w", house.Name, err)
+		}
+	}
+
+	house.Description = strings.Join(desc, "\n")
+
+	return house, nil
+}
+
+// readSizeAndRent reads lines such as
+// "The house has a size of 7 square meters. The monthly rent is 50,000 gold
+// and will be debited to the bank account on Antica.".
+func (p *Parser) readSizeAndRent(house *tibia.House, line string) error {
+	var err error
+
+	house.Size, err = p.readInt(line, sizeIndexer, endSizeIndexer)
+	if err != nil {
+		return fmt.Errorf("size: %w", err)
+	}
+
+	house.Rent, err = p.readInt(line, rentIndexer, endRentIndexer)
+	if err != nil {
+		return fmt.Errorf("rent: %w", err)
+	}
+
+	return nil
+}
+
+// readOwner reads lines such as
+// "The house has been rented by Kharsek Valdor. He has paid the rent until
+// Jul 20 2023, 10:00:00 CEST.".
+func (p *Parser) readOwner(house *tibia.House, line string) error {
+	house.Status = tibia.HouseStatusRented
+
+	owner, _, ok := scrape.Between(line, ownerIndexer, endOwnerIndexer)
+	if !ok {
+		return fmt.Errorf("owner not found")
+	}
+	house.Owner = owner
+
+	_, paidUntil, ok := strings.Cut(line, paidUntilIndexer)
+	if !ok {
+		return fmt.Errorf("paid until not found")
+	}
+
+	t, err := scrape.DateTime(strings.TrimSuffix(paidUntil, "."))
+	if err != nil {
+		return fmt.Errorf("paid until: %w", err)
+	}
+	house.PaidUntil = t
+
+	return nil
+}
+
+// readTransfer reads lines such as
+// "He will move out on Jul 25 2023, 10:00:00 CEST (time of daily server save)
+// and will pass the house to Nandor for 100,000 gold coins.".
+//
+// The part about passing the house is only displayed if the owner chose a
+// new owner.
+func (p *Parser) readTransfer(house *tibia.House, line string) error {
+	date, _, ok := scrape.Between(line, moveOutIndexer, endMoveOutIndexer)
+	if !ok {
+		return fmt.Errorf("move out date not found")
+	}
+
+	t, err := scrape.DateTime(date)
+	if err != nil {
+		return fmt.Errorf("move out date: %w", err)
+	}
+
+	house.Transfer = &tibia.HouseTransfer{
+		Date: t,
+	}
+
+	newOwner, rest, ok := scrape.Between(
+		line, newOwnerIndexer, endNewOwnerIndexer,
+	)
+	if !ok {
+		return nil
+	}
+	house.Transfer.NewOwner = newOwner
+
+	house.Transfer.Price, err = p.readInt(rest, "", endPriceIndexer)
+	if err != nil {
+		return fmt.Errorf("transfer price: %w", err)
+	}
+
+	return nil
+}
+
+// readAuction reads lines such as
+// "The house is currently being auctioned. The auction will end at
+// Dec 10 2023, 10:00:00 CET. The highest bid so far is 105,000 gold and has
+// been submitted by Sandy Andersen.".
+func (p *Parser) readAuction(house *tibia.House, line string) error {
+	house.Status = tibia.HouseStatusAuctioned
+
+	end, _, ok := scrape.Between(line, auctionEndIndexer, endAuctionEndIndexer)
+	if !ok {
+		return fmt.Errorf("auction end not found")
+	}
+
+	t, err := scrape.DateTime(end)
+	if err != nil {
+		return fmt.Errorf("auction end: %w", err)
+	}
+
+	house.Auction = &tibia.HouseAuction{
+		End: t,
+	}
+
+	if !strings.Contains(line, highestBidIndexer) {
+		// No bid has been submitted so far.
+		return nil
+	}
+
+	house.Auction.HighestBid, err = p.readInt(
+		line, highestBidIndexer, endHighestBidIndexer,
+	)
+	if err != nil {
+		return fmt.Errorf("highest bid: %w", err)
+	}
+
+	_, bidder, ok := strings.Cut(line, bidderIndexer)
+	if !ok {
+		return fmt.Errorf("bidder not found")
+	}
+	house.Auction.Bidder = strings.TrimSuffix(bidder, ".")
+
+	return nil
+}
+
+func (p *Parser) readInt(text, start, end string) (int, error) {
+	s, _, ok := scrape.Between(text, start, end)
+	if !ok {
+		return 0, fmt.Errorf("%q not found", strings.TrimSpace(end))
+	}
+	return scrape.Int(s)
+}
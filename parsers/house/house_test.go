@@ -0,0 +1,133 @@
+package house
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "house.html")
+
+	p := Parser{}
+
+	house, err := p.parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	paidUntil := time.Date(2023, time.July, 20, 8, 0, 0, 0, time.UTC)
+	if !house.PaidUntil.Equal(paidUntil) {
+		t.Errorf(
+			"Wrong paid until\nwant: %s\ngot: %s", paidUntil, house.PaidUntil,
+		)
+	}
+	house.PaidUntil = paidUntil
+
+	moveOut := time.Date(2023, time.July, 25, 8, 0, 0, 0, time.UTC)
+	if house.Transfer != nil {
+		if !house.Transfer.Date.Equal(moveOut) {
+			t.Errorf(
+				"Wrong move out date\nwant: %s\ngot: %s",
+				moveOut, house.Transfer.Date,
+			)
+		}
+		house.Transfer.Date = moveOut
+	}
+
+	want := tibia.House{
+		Name:        "Beach Home Apartments, Flat 14",
+		ImageURL:    "https://static.tibia.com/images/houses/house_10101.png",
+		Description: "A cozy flat right at the beach.",
+		Beds:        2,
+		Size:        7,
+		Rent:        50000,
+		Status:      tibia.HouseStatusRented,
+		Owner:       "Kharsek Valdor",
+		PaidUntil:   paidUntil,
+		Transfer: &tibia.HouseTransfer{
+			Date:     moveOut,
+			NewOwner: "Nandor",
+			Price:    100000,
+		},
+	}
+
+	if !reflect.DeepEqual(want, house) {
+		t.Errorf("Wrong house\nwant: %+v\ngot: %+v", want, house)
+	}
+}
+
+func TestParserAuctioned(t *testing.T) {
+	data := static.MustRead(t, "house_auctioned.html")
+
+	p := Parser{}
+
+	house, err := p.parse(data)
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	end := time.Date(2023, time.December, 10, 9, 0, 0, 0, time.UTC)
+	if house.Auction != nil {
+		if !house.Auction.End.Equal(end) {
+			t.Errorf(
+				"Wrong auction end\nwant: %s\ngot: %s", end, house.Auction.End,
+			)
+		}
+		house.Auction.End = end
+	}
+
+	want := tibia.House{
+		Name:     "Thais Clanhall",
+		ImageURL: "https://static.tibia.com/images/houses/house_10103.png",
+		Beds:     1,
+		Size:     215,
+		Rent:     2000000,
+		Status:   tibia.HouseStatusAuctioned,
+		Auction: &tibia.HouseAuction{
+			End:        end,
+			HighestBid: 105000,
+			Bidder:     "Sandy Andersen",
+		},
+	}
+
+	if !reflect.DeepEqual(want, house) {
+		t.Errorf("Wrong house\nwant: %+v\ngot: %+v", want, house)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	data := static.MustRead(t, "houses.html")
+
+	p := Parser{}
+
+	if _, err := p.parse(data); !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{
+		{ID: 10101},
+		{World: "Antica"},
+		{World: "Antica", ID: -1},
+	} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
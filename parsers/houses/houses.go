@@ -0,0 +1,288 @@
+// Package houses provides an implementation of the Parser interface for
+// parsing the houses of a town from the tibia.com Houses page.
+//
+// To use the houses package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the desired filters to fetch the
+// HTML content from the Houses page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package houses
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=houses"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the houses endpoint.
+	contentLength = 80000
+)
+
+var _ parsers.Parser[Args, tibia.Houses] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the houses of a town from the tibia.com Houses page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// World is the name of the world whose houses will be parsed.
+	//
+	// World must be a valid world name, see tibia.IsWorldNameValid.
+	// Otherwise, parsers.ErrInvalidArgs is returned.
+	World string
+
+	// Town is the town whose houses will be parsed.
+	Town tibia.Town
+
+	// Type is the type of the houses to be parsed.
+	Type tibia.HouseType
+
+	// Status is the status of the houses to filter by.
+	//
+	// If Status is nil, houses with any status are included.
+	Status *tibia.HouseStatus
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the houses, which happens when the world does
+// not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Houses, error) {
+	if !tibia.IsWorldNameValid(args.World) {
+		return tibia.Houses{}, fmt.Errorf(
+			"houses: invalid world %q: %w", args.World, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.housesURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Houses{}, fmt.Errorf("houses: %w", err)
+	}
+
+	houses, err := p.parse(data, args)
+	if err != nil {
+		return tibia.Houses{}, fmt.Errorf(
+			"houses: failed to parse body: %w", err,
+		)
+	}
+
+	return houses, nil
+}
+
+func (p *Parser) housesURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("world", args.World)
+	vals.Set(args.Town.QueryKey(), args.Town.QueryVal())
+	vals.Set(args.Type.QueryKey(), args.Type.QueryVal())
+	if args.Status != nil {
+		vals.Set(args.Status.QueryKey(), args.Status.QueryVal())
+	}
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	housesCaption     = "Available Houses and Flats in %s on %s"
+	guildhallsCaption = "Available Guildhalls in %s on %s"
+
+	houseIDIndexer = `name="houseid" value="`
+	endAttrIndexer = `"`
+
+	sizeSuffix     = " sqm"
+	rentSuffix     = " gold"
+	thousandSuffix = "k"
+
+	rentedStatus    = "rented"
+	auctionedStatus = "auctioned"
+	noBidStatus     = "auctioned (no bid yet)"
+
+	bidIndexer    = "("
+	endBidIndexer = " gold; "
+	endTimeLeft   = " left)"
+)
+
+func (p *Parser) parse(data string, args Args) (tibia.Houses, error) {
+	houses := tibia.Houses{
+		World: args.World,
+		Town:  args.Town,
+		Type:  args.Type,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return houses, fmt.Errorf("houses: %w", err)
+	}
+
+	caption := housesCaption
+	if args.Type == tibia.HouseTypeGuildhall {
+		caption = guildhallsCaption
+	}
+
+	table, ok := scrape.Table(
+		content, fmt.Sprintf(caption, args.Town.String(), args.World),
+	)
+	if !ok {
+		return houses, fmt.Errorf(
+			"houses: %q: %w", args.World, parsers.ErrNotFound,
+		)
+	}
+
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 5 {
+			// Rows such as "No house found.".
+			continue
+		}
+
+		// Skip the header row.
+		if !strings.Contains(cells[4], houseIDIndexer) {
+			continue
+		}
+
+		house, err := p.readHouse(cells)
+		if err != nil {
+			return houses, fmt.Errorf("houses: %w", err)
+		}
+
+		houses.Houses = append(houses.Houses, house)
+	}
+
+	return houses, nil
+}
+
+func (p *Parser) readHouse(cells []string) (tibia.HouseOverview, error) {
+	house := tibia.HouseOverview{
+		Name: scrape.Text(cells[0]),
+	}
+
+	id, _, _ := scrape.Between(cells[4], houseIDIndexer, endAttrIndexer)
+
+	var err error
+
+	house.ID, err = scrape.Int(id)
+	if err != nil {
+		return house, fmt.Errorf("%s id: %w", house.Name, err)
+	}
+
+	house.Size, err = scrape.Int(
+		strings.TrimSuffix(scrape.Text(cells[1]), sizeSuffix),
+	)
+	if err != nil {
+		return house, fmt.Errorf("%s size: %w", house.Name, err)
+	}
+
+	house.Rent, err = p.readGold(
+		strings.TrimSuffix(scrape.Text(cells[2]), rentSuffix),
+	)
+	if err != nil {
+		return house, fmt.Errorf("%s rent: %w", house.Name, err)
+	}
+
+	if err := p.readStatus(&house, scrape.Text(cells[3])); err != nil {
+		return house, fmt.Errorf("%s status: %w", house.Name, err)
+	}
+
+	return house, nil
+}
+
+// readGold reads amounts such as "500", "50k" or "2kk".
+func (p *Parser) readGold(s string) (int, error) {
+	multiplier := 1
+	for strings.HasSuffix(s, thousandSuffix) {
+		s = strings.TrimSuffix(s, thousandSuffix)
+		multiplier *= 1000
+	}
+
+	n, err := scrape.Int(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return n * multiplier, nil
+}
+
+// readStatus reads statuses such as "rented", "auctioned (no bid yet)" or
+// "auctioned (105,000 gold; 5 days left)".
+func (p *Parser) readStatus(house *tibia.HouseOverview, status string) error {
+	switch {
+	case status == rentedStatus:
+		house.Status = tibia.HouseStatusRented
+		return nil
+	case status == noBidStatus:
+		house.Status = tibia.HouseStatusAuctioned
+		return nil
+	case !strings.HasPrefix(status, auctionedStatus):
+		return fmt.Errorf("%q: %w", status, tibia.ErrUnknownHouseStatus)
+	}
+
+	house.Status = tibia.HouseStatusAuctioned
+
+	bid, rest, ok := scrape.Between(status, bidIndexer, endBidIndexer)
+	if !ok {
+		return fmt.Errorf("current bid not found")
+	}
+
+	var err error
+
+	house.CurrentBid, err = scrape.Int(bid)
+	if err != nil {
+		return fmt.Errorf("current bid: %w", err)
+	}
+
+	house.TimeLeft, err = p.readTimeLeft(
+		strings.TrimSuffix(rest, endTimeLeft),
+	)
+	if err != nil {
+		return fmt.Errorf("time left: %w", err)
+	}
+
+	return nil
+}
+
+// readTimeLeft reads durations such as "5 days", "1 day" or "20 hours".
+func (p *Parser) readTimeLeft(s string) (time.Duration, error) {
+	amount, unit, ok := strings.Cut(s, " ")
+	if !ok {
+		return 0, fmt.Errorf("unknown time left: %q", s)
+	}
+
+	n, err := strconv.Atoi(amount)
+	if err != nil {
+		return 0, err
+	}
+
+	switch strings.TrimSuffix(unit, "s") {
+	case "day":
+		return time.Duration(n) * 24 * time.Hour, nil
+	case "hour":
+		return time.Duration(n) * time.Hour, nil
+	case "minute":
+		return time.Duration(n) * time.Minute, nil
+	default:
+		return 0, fmt.Errorf("unknown time left: %q", s)
+	}
+}
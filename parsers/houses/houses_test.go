@@ -0,0 +1,135 @@
+package houses
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	f, err := static.TestData.Open("testdata/houses.html")
+	if err != nil {
+		t.Errorf("failed to open test data: %s\n%#v\n", err, err)
+		return
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+
+	p := Parser{}
+
+	args := Args{
+		World: "Antica",
+		Town:  tibia.TownThais,
+		Type:  tibia.HouseTypeHouse,
+	}
+
+	houses, err := p.parse(string(data), args)
+	if err != nil {
+		t.Errorf("failed to parse data: %s\n%#v\n", err, err)
+		return
+	}
+
+	want := tibia.Houses{
+		World: "Antica",
+		Town:  tibia.TownThais,
+		Type:  tibia.HouseTypeHouse,
+		Houses: []tibia.HouseOverview{
+			{
+				ID:     10101,
+				Name:   "Beach Home Apartments, Flat 14",
+				Size:   7,
+				Rent:   50000,
+				Status: tibia.HouseStatusRented,
+			},
+			{
+				ID:     10102,
+				Name:   "Harbour Street 4",
+				Size:   43,
+				Rent:   250000,
+				Status: tibia.HouseStatusAuctioned,
+			},
+			{
+				ID:         10103,
+				Name:       "Thais Clanhall",
+				Size:       215,
+				Rent:       2000000,
+				Status:     tibia.HouseStatusAuctioned,
+				CurrentBid: 105000,
+				TimeLeft:   5 * 24 * time.Hour,
+			},
+			{
+				ID:         10104,
+				Name:       "Upper Swamp Lane 8",
+				Size:       94,
+				Rent:       500,
+				Status:     tibia.HouseStatusAuctioned,
+				CurrentBid: 1000,
+				TimeLeft:   20 * time.Hour,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(want, houses) {
+		t.Errorf("Wrong houses\nwant: %+v\ngot: %+v", want, houses)
+	}
+
+	args.Type = tibia.HouseTypeGuildhall
+	if _, err := p.parse(string(data), args); !errors.Is(
+		err, parsers.ErrNotFound,
+	) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestHousesURL(t *testing.T) {
+	p := Parser{}
+
+	status := tibia.HouseStatusAuctioned
+
+	u, err := url.Parse(p.housesURL(Args{
+		World:  "Antica",
+		Town:   tibia.TownAbDendriel,
+		Type:   tibia.HouseTypeGuildhall,
+		Status: &status,
+	}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic": {"houses"},
+		"world":    {"Antica"},
+		"town":     {"Ab'Dendriel"},
+		"type":     {"guildhalls"},
+		"state":    {"auctioned"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
+
+func TestParserInvalidWorld(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.Parse(context.Background(), Args{}, parsers.Options{})
+	if !errors.Is(err, parsers.ErrInvalidArgs) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrInvalidArgs, err,
+		)
+	}
+}
@@ -0,0 +1,166 @@
+// Package killstatistics provides an implementation of the Parser interface
+// for parsing the kill statistics of a world from the tibia.com Kill
+// Statistics page.
+//
+// To use the killstatistics package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the name of the world to fetch the
+// HTML content from the Kill Statistics page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package killstatistics
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=killstatistics"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the kill statistics endpoint.
+	contentLength = 200000
+)
+
+var _ parsers.Parser[Args, tibia.KillStatistics] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the kill statistics of a world from the tibia.com Kill Statistics
+// page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// World is the name of the world whose kill statistics will be parsed.
+	//
+	// World must be a valid world name, see tibia.IsWorldNameValid.
+	// Otherwise, parsers.ErrInvalidArgs is returned.
+	World string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the kill statistics of the world, which
+// happens when the world does not exist, an error wrapping parsers.ErrNotFound
+// is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.KillStatistics, error) {
+	if !tibia.IsWorldNameValid(args.World) {
+		return tibia.KillStatistics{}, fmt.Errorf(
+			"killstatistics: invalid world %q: %w",
+			args.World, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.killStatisticsURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.KillStatistics{}, fmt.Errorf("killstatistics: %w", err)
+	}
+
+	stats, err := p.parse(data, args.World)
+	if err != nil {
+		return tibia.KillStatistics{}, fmt.Errorf(
+			"killstatistics: failed to parse body: %w", err,
+		)
+	}
+
+	return stats, nil
+}
+
+func (p *Parser) killStatisticsURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("world", args.World)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	statisticsCaption = "Kill Statistics"
+
+	totalRace = "Total"
+)
+
+func (p *Parser) parse(data, world string) (tibia.KillStatistics, error) {
+	stats := tibia.KillStatistics{
+		World: world,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return stats, fmt.Errorf("killstatistics: %w", err)
+	}
+
+	table, ok := scrape.Table(content, statisticsCaption)
+	if !ok {
+		return stats, fmt.Errorf(
+			"killstatistics: %q: %w", world, parsers.ErrNotFound,
+		)
+	}
+
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 5 {
+			continue
+		}
+
+		// Skip the header rows.
+		if _, err := scrape.Int(scrape.Text(cells[1])); err != nil {
+			continue
+		}
+
+		entry, err := p.readEntry(cells)
+		if err != nil {
+			return stats, fmt.Errorf("killstatistics: %w", err)
+		}
+
+		if entry.Race == totalRace {
+			entry.Race = ""
+			stats.Total = entry
+			continue
+		}
+
+		stats.Entries = append(stats.Entries, entry)
+	}
+
+	return stats, nil
+}
+
+func (p *Parser) readEntry(cells []string) (tibia.KillStatisticsEntry, error) {
+	entry := tibia.KillStatisticsEntry{
+		Race: scrape.Text(cells[0]),
+	}
+
+	fields := []*int{
+		&entry.LastDayKilledPlayers,
+		&entry.LastDayKilledByPlayers,
+		&entry.LastWeekKilledPlayers,
+		&entry.LastWeekKilledByPlayers,
+	}
+
+	for i, field := range fields {
+		n, err := scrape.Int(scrape.Text(cells[i+1]))
+		if err != nil {
+			return entry, fmt.Errorf("%s column %d: %w", entry.Race, i+1, err)
+		}
+		*field = n
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,102 @@
+package killstatistics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	f, err := static.TestData.Open("testdata/killstatistics.html")
+	if err != nil {
+		t.Errorf("failed to open test data: %s\n%#v\n", err, err)
+		return
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+
+	p := Parser{}
+
+	stats, err := p.parse(string(data), "Antica")
+	if err != nil {
+		t.Errorf("failed to parse data: %s\n%#v\n", err, err)
+		return
+	}
+
+	want := tibia.KillStatistics{
+		World: "Antica",
+		Entries: []tibia.KillStatisticsEntry{
+			{
+				Race:                    "acid blobs",
+				LastDayKilledByPlayers:  15,
+				LastWeekKilledByPlayers: 122,
+			},
+			{
+				Race:                    "dragon lords",
+				LastDayKilledPlayers:    3,
+				LastDayKilledByPlayers:  1204,
+				LastWeekKilledPlayers:   17,
+				LastWeekKilledByPlayers: 8930,
+			},
+			{
+				Race:                    "Ferumbras",
+				LastDayKilledPlayers:    1,
+				LastDayKilledByPlayers:  1,
+				LastWeekKilledPlayers:   2,
+				LastWeekKilledByPlayers: 1,
+			},
+			{
+				Race:                    "Grand Master Oberon",
+				LastWeekKilledByPlayers: 3,
+			},
+			{
+				Race:                    "rats",
+				LastDayKilledByPlayers:  412,
+				LastWeekKilledPlayers:   1,
+				LastWeekKilledByPlayers: 2873,
+			},
+		},
+		Total: tibia.KillStatisticsEntry{
+			LastDayKilledPlayers:    4,
+			LastDayKilledByPlayers:  1632,
+			LastWeekKilledPlayers:   20,
+			LastWeekKilledByPlayers: 11929,
+		},
+	}
+
+	if !reflect.DeepEqual(want, stats) {
+		t.Errorf("Wrong kill statistics\nwant: %+v\ngot: %+v", want, stats)
+	}
+
+	ferumbras, ok := stats.Race("ferumbras")
+	if !ok || ferumbras.LastDayKilledByPlayers != 1 {
+		t.Errorf("Wrong race\nwant: %+v\ngot: %+v", want.Entries[2], ferumbras)
+	}
+
+	if _, ok := stats.Race("Morgaroth"); ok {
+		t.Errorf("Race must not be found")
+	}
+}
+
+func TestParserInvalidWorld(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.Parse(
+		context.Background(), Args{World: "antica"}, parsers.Options{},
+	)
+	if !errors.Is(err, parsers.ErrInvalidArgs) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrInvalidArgs, err,
+		)
+	}
+}
@@ -0,0 +1,175 @@
+// Package latestnews provides an implementation of the Parser interface for
+// parsing the featured news and the news ticker from the tibia.com Latest News
+// page.
+//
+// To use the latestnews package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called to fetch the HTML content from the
+// Latest News page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package latestnews
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/article"
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/news/?subtopic=latestnews"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the latest news endpoint.
+	contentLength = 60000
+)
+
+var _ parsers.Parser[Args, tibia.LatestNews] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the featured news and the news ticker from the tibia.com Latest News
+// page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface, but it is
+// not used by this implementation.
+type Args struct{}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.LatestNews, error) {
+	data, err := fetch.Get(ctx, p.URL(), opts, contentLength)
+	if err != nil {
+		return tibia.LatestNews{}, fmt.Errorf("latestnews: %w", err)
+	}
+
+	news, err := p.parse(data)
+	if err != nil {
+		return tibia.LatestNews{}, fmt.Errorf(
+			"latestnews: failed to parse body: %w", err,
+		)
+	}
+
+	return news, nil
+}
+
+const (
+	tickerBoxIndexer = `<div id="NewsTicker" class="Box">`
+	newsBoxIndexer   = `<div id="News" class="Box">`
+
+	tickerRowIndexer = `<div class="Row"`
+	tickerDateClass  = "NewsTickerDate"
+	tickerTextClass  = "NewsTickerFullText"
+)
+
+func (p *Parser) parse(data string) (tibia.LatestNews, error) {
+	var news tibia.LatestNews
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return news, fmt.Errorf("latestnews: %w", err)
+	}
+
+	tickerIdx := strings.Index(content, tickerBoxIndexer)
+	newsIdx := strings.Index(content, newsBoxIndexer)
+	if tickerIdx == -1 && newsIdx == -1 {
+		return news, fmt.Errorf("latestnews: news not found")
+	}
+
+	if tickerIdx != -1 {
+		ticker := content[tickerIdx:]
+		if newsIdx > tickerIdx {
+			ticker = content[tickerIdx:newsIdx]
+		}
+
+		news.Ticker, err = p.readTicker(ticker)
+		if err != nil {
+			return news, fmt.Errorf("latestnews: ticker: %w", err)
+		}
+	}
+
+	if newsIdx != -1 {
+		articles := content[newsIdx:]
+		if tickerIdx > newsIdx {
+			articles = content[newsIdx:tickerIdx]
+		}
+
+		news.Articles, err = p.readArticles(articles)
+		if err != nil {
+			return news, fmt.Errorf("latestnews: articles: %w", err)
+		}
+	}
+
+	return news, nil
+}
+
+func (p *Parser) readTicker(box string) ([]tibia.News, error) {
+	rows := strings.Split(box, tickerRowIndexer)
+
+	entries := make([]tibia.News, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		entry := tibia.News{
+			Type: tibia.NewsTypeTicker,
+			Body: article.ReadBody(
+				scrape.InnerByClass(row, "div", tickerTextClass),
+			),
+		}
+
+		var err error
+
+		entry.Category, err = article.ReadCategory(row)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+
+		entry.Date, err = article.ReadDate(
+			scrape.InnerByClass(row, "span", tickerDateClass),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (p *Parser) readArticles(box string) ([]tibia.News, error) {
+	headlines := strings.Split(box, article.HeadlineIndexer)
+
+	articles := make([]tibia.News, 0, len(headlines)-1)
+	for i, headline := range headlines[1:] {
+		entry, err := article.Read(headline)
+		if err != nil {
+			return nil, fmt.Errorf("article %d: %w", i+1, err)
+		}
+
+		// Every article of the Latest News page links to the News Archive.
+		if entry.ID == 0 {
+			return nil, fmt.Errorf(
+				"article %d: %s: id not found", i+1, entry.Title,
+			)
+		}
+
+		articles = append(articles, entry)
+	}
+
+	return articles, nil
+}
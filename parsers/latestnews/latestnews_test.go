@@ -0,0 +1,89 @@
+package latestnews
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	news, err := p.parse(static.MustRead(t, "latestnews.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	want := tibia.LatestNews{
+		Articles: []tibia.News{
+			{
+				ID:       7134,
+				Date:     date(time.July, 4),
+				Category: tibia.NewsCategoryDevelopment,
+				Type:     tibia.NewsTypeNews,
+				Title:    "Summer Update 2023",
+				Body: "Dear Tibians,\n" +
+					"the summer update is live! Explore the new areas of " +
+					"the Rotten Blood quest line and face its terrible " +
+					"bosses.\n" +
+					"Have fun!\n" +
+					"Your Tibia Team",
+			},
+			{
+				ID:       7129,
+				Date:     date(time.June, 28),
+				Category: tibia.NewsCategoryCipSoft,
+				Type:     tibia.NewsTypeNews,
+				Title:    "Tibia's 26th Anniversary",
+				Body: "Tibia turned 26 and we celebrate it with you. The " +
+					"anniversary quest is available until July 12.",
+			},
+		},
+		Ticker: []tibia.News{
+			{
+				Date:     date(time.July, 6),
+				Category: tibia.NewsCategoryCommunity,
+				Type:     tibia.NewsTypeTicker,
+				Body: "The winners of the summer fan art contest have been " +
+					"chosen! Thank you all for participating, we received " +
+					"more than 300 entries.\n" +
+					"Check out the fansites to see them all.",
+			},
+			{
+				Date:     date(time.July, 5),
+				Category: tibia.NewsCategoryTechnical,
+				Type:     tibia.NewsTypeTicker,
+				Body: "Some players experienced connection problems this " +
+					"morning. The issue has been fixed, we apologise for " +
+					"the inconvenience.",
+			},
+			{
+				Date:     date(time.July, 3),
+				Category: tibia.NewsCategorySupport,
+				Type:     tibia.NewsTypeTicker,
+				Body: "Please remember: CipSoft will never ask for your " +
+					"password. Do not share it with anybody, not even with " +
+					"people claiming to be gamemasters & tutors.",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(news, want) {
+		t.Errorf("Wrong news\nwant: %+v\ngot: %+v", want, news)
+	}
+}
+
+func TestParserNoNews(t *testing.T) {
+	p := Parser{}
+
+	if _, err := p.parse(static.MustRead(t, "world.html")); err == nil {
+		t.Errorf("expected an error when the page has no news")
+	}
+}
@@ -0,0 +1,126 @@
+// Package news provides an implementation of the Parser interface for parsing
+// a single news entry from the tibia.com News Archive.
+//
+// To use the news package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the ID of the news entry to fetch
+// the HTML content from the News Archive page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// The IDs of the news entries can be found with the newsarchive package.
+package news
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/article"
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/news/?subtopic=newsarchive"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the news endpoint.
+	contentLength = 40000
+)
+
+var _ parsers.Parser[Args, tibia.News] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing a single news entry from the tibia.com News Archive.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// ID is the ID of the news entry to be parsed.
+	//
+	// ID must be greater than 0. Otherwise, parsers.ErrInvalidArgs is
+	// returned.
+	ID int
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// tibia.com does not tell featured articles apart from regular news when
+// displaying a single entry, so the Type of the returned news is either
+// tibia.NewsTypeTicker or tibia.NewsTypeNews. The News Archive search reports
+// the actual type.
+//
+// If tibia.com does not display the news entry, which happens when it does
+// not exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.News, error) {
+	if args.ID <= 0 {
+		return tibia.News{}, fmt.Errorf(
+			"news: invalid id %d: %w", args.ID, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.newsURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.News{}, fmt.Errorf("news: %w", err)
+	}
+
+	news, err := p.parse(data)
+	if err != nil {
+		return tibia.News{}, fmt.Errorf("news: failed to parse body: %w", err)
+	}
+
+	// The ID is not part of the news entry.
+	news.ID = args.ID
+
+	return news, nil
+}
+
+func (p *Parser) newsURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("id", strconv.Itoa(args.ID))
+	return p.URL() + "&" + vals.Encode()
+}
+
+// tickerTitle is the title tibia.com displays for news ticker entries.
+const tickerTitle = "News Ticker"
+
+func (p *Parser) parse(data string) (tibia.News, error) {
+	content, err := scrape.Content(data)
+	if err != nil {
+		return tibia.News{}, fmt.Errorf("news: %w", err)
+	}
+
+	_, s, ok := strings.Cut(content, article.HeadlineIndexer)
+	if !ok {
+		return tibia.News{}, fmt.Errorf("news: %w", parsers.ErrNotFound)
+	}
+
+	news, err := article.Read(s)
+	if err != nil {
+		return news, fmt.Errorf("news: %w", err)
+	}
+
+	if news.Title == tickerTitle {
+		news.Type = tibia.NewsTypeTicker
+		news.Title = ""
+	}
+
+	return news, nil
+}
@@ -0,0 +1,108 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		file string
+		want tibia.News
+	}{
+		{
+			name: "news",
+			file: "news.html",
+			want: tibia.News{
+				Date:     time.Date(2023, time.July, 4, 0, 0, 0, 0, time.UTC),
+				Category: tibia.NewsCategoryDevelopment,
+				Type:     tibia.NewsTypeNews,
+				Title:    "Summer Update 2023",
+				Body: "Dear Tibians,\n" +
+					"the summer update is live! Explore the new areas of " +
+					"the Rotten Blood quest line and face its terrible " +
+					"bosses.\n" +
+					"Have fun!\n" +
+					"Your Tibia Team",
+			},
+		},
+		{
+			name: "ticker",
+			file: "news_ticker.html",
+			want: tibia.News{
+				Date:     time.Date(2023, time.July, 6, 0, 0, 0, 0, time.UTC),
+				Category: tibia.NewsCategoryCommunity,
+				Type:     tibia.NewsTypeTicker,
+				Body: "The winners of the summer fan art contest have been " +
+					"chosen! Thank you all for participating, we received " +
+					"more than 300 entries.\n" +
+					"Check out the fansites to see them all.",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := Parser{}
+
+			news, err := p.parse(static.MustRead(t, tc.file))
+			if err != nil {
+				t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+			}
+
+			if !reflect.DeepEqual(news, tc.want) {
+				t.Errorf("Wrong news\nwant: %+v\ngot: %+v", tc.want, news)
+			}
+		})
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "newsarchive.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{{}, {ID: -1}} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestNewsURL(t *testing.T) {
+	p := Parser{}
+
+	u, err := url.Parse(p.newsURL(Args{ID: 7134}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic": {"newsarchive"},
+		"id":       {"7134"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
@@ -0,0 +1,239 @@
+// Package newsarchive provides an implementation of the Parser interface for
+// searching the tibia.com News Archive.
+//
+// To use the newsarchive package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the period and the filters to be
+// searched to submit the search form of the News Archive page, parse the
+// results, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// To fetch the full text of a news entry, see the news package.
+package newsarchive
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/article"
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/news/?subtopic=newsarchive"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the news archive endpoint.
+	contentLength = 50000
+)
+
+var (
+	allCategories = []tibia.NewsCategory{
+		tibia.NewsCategoryCipSoft,
+		tibia.NewsCategoryCommunity,
+		tibia.NewsCategoryDevelopment,
+		tibia.NewsCategorySupport,
+		tibia.NewsCategoryTechnical,
+	}
+
+	allTypes = []tibia.NewsType{
+		tibia.NewsTypeNews,
+		tibia.NewsTypeTicker,
+		tibia.NewsTypeFeaturedArticle,
+	}
+)
+
+var _ parsers.Parser[Args, tibia.NewsArchive] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// searching the tibia.com News Archive.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Begin is the first day of the period to be searched. Only its date is
+	// used.
+	//
+	// Begin must not be zero. Otherwise, parsers.ErrInvalidArgs is returned.
+	Begin time.Time
+
+	// End is the last day of the period to be searched. Only its date is used.
+	//
+	// End must not be before Begin. Otherwise, parsers.ErrInvalidArgs is
+	// returned.
+	End time.Time
+
+	// Categories are the categories of the news entries to search for.
+	//
+	// If Categories is empty, news entries of every category are included.
+	Categories []tibia.NewsCategory
+
+	// Types are the types of the news entries to search for.
+	//
+	// If Types is empty, news entries of every type are included.
+	Types []tibia.NewsType
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.NewsArchive, error) {
+	if args.Begin.IsZero() {
+		return tibia.NewsArchive{}, fmt.Errorf(
+			"newsarchive: begin is zero: %w", parsers.ErrInvalidArgs,
+		)
+	}
+
+	if args.End.Before(args.Begin) {
+		return tibia.NewsArchive{}, fmt.Errorf(
+			"newsarchive: end %s is before begin %s: %w",
+			args.End.Format(time.DateOnly), args.Begin.Format(time.DateOnly),
+			parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.PostForm(
+		ctx, p.URL(), p.searchForm(args), opts, contentLength,
+	)
+	if err != nil {
+		return tibia.NewsArchive{}, fmt.Errorf("newsarchive: %w", err)
+	}
+
+	archive, err := p.parse(data)
+	if err != nil {
+		return tibia.NewsArchive{}, fmt.Errorf(
+			"newsarchive: failed to parse body: %w", err,
+		)
+	}
+
+	archive.Begin = truncateDay(args.Begin)
+	archive.End = truncateDay(args.End)
+
+	return archive, nil
+}
+
+func (p *Parser) searchForm(args Args) url.Values {
+	vals := url.Values{}
+
+	setDate := func(prefix string, t time.Time) {
+		vals.Set(prefix+"_day", strconv.Itoa(t.Day()))
+		vals.Set(prefix+"_month", strconv.Itoa(int(t.Month())))
+		vals.Set(prefix+"_year", strconv.Itoa(t.Year()))
+	}
+	setDate("filter_begin", args.Begin)
+	setDate("filter_end", args.End)
+
+	categories := args.Categories
+	if len(categories) == 0 {
+		categories = allCategories
+	}
+	for _, category := range categories {
+		vals.Set(category.QueryKey(), category.QueryVal())
+	}
+
+	types := args.Types
+	if len(types) == 0 {
+		types = allTypes
+	}
+	for _, typ := range types {
+		vals.Set(typ.QueryKey(), typ.QueryVal())
+	}
+
+	return vals
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+const (
+	resultsCaption = "Search Results"
+
+	idIndexer    = "id="
+	endIDIndexer = `"`
+)
+
+func (p *Parser) parse(data string) (tibia.NewsArchive, error) {
+	var archive tibia.NewsArchive
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return archive, fmt.Errorf("newsarchive: %w", err)
+	}
+
+	table, ok := scrape.Table(content, resultsCaption)
+	if !ok {
+		return archive, fmt.Errorf("newsarchive: %w", parsers.ErrNotFound)
+	}
+
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 4 {
+			// Rows such as "No news found.".
+			continue
+		}
+
+		// Skip the header row.
+		if !strings.Contains(cells[3], idIndexer) {
+			continue
+		}
+
+		news, err := p.readNews(cells)
+		if err != nil {
+			return archive, fmt.Errorf("newsarchive: %w", err)
+		}
+
+		archive.News = append(archive.News, news)
+	}
+
+	return archive, nil
+}
+
+func (p *Parser) readNews(cells []string) (tibia.News, error) {
+	news := tibia.News{
+		Title: scrape.Text(cells[3]),
+	}
+
+	id, _, _ := scrape.Between(cells[3], idIndexer, endIDIndexer)
+
+	var err error
+
+	news.ID, err = scrape.Int(id)
+	if err != nil {
+		return news, fmt.Errorf("%s id: %w", news.Title, err)
+	}
+
+	news.Category, err = article.ReadCategory(cells[0])
+	if err != nil {
+		return news, fmt.Errorf("%s: %w", news.Title, err)
+	}
+
+	news.Date, err = article.ReadDate(cells[1])
+	if err != nil {
+		return news, fmt.Errorf("%s: %w", news.Title, err)
+	}
+
+	news.Type, err = tibia.NewsTypeFromString(scrape.Text(cells[2]))
+	if err != nil {
+		return news, fmt.Errorf("%s type: %w", news.Title, err)
+	}
+
+	return news, nil
+}
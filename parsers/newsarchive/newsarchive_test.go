@@ -0,0 +1,160 @@
+package newsarchive
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	archive, err := p.parse(static.MustRead(t, "newsarchive.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	want := tibia.NewsArchive{
+		News: []tibia.News{
+			{
+				ID:       7140,
+				Date:     date(time.July, 6),
+				Category: tibia.NewsCategoryCommunity,
+				Type:     tibia.NewsTypeTicker,
+				Title:    "The winners of the summer fan art contest...",
+			},
+			{
+				ID:       7134,
+				Date:     date(time.July, 4),
+				Category: tibia.NewsCategoryDevelopment,
+				Type:     tibia.NewsTypeNews,
+				Title:    "Summer Update 2023",
+			},
+			{
+				ID:       7131,
+				Date:     date(time.July, 1),
+				Category: tibia.NewsCategoryDevelopment,
+				Type:     tibia.NewsTypeFeaturedArticle,
+				Title:    "The Making of the Summer Update",
+			},
+			{
+				ID:       7129,
+				Date:     date(time.June, 28),
+				Category: tibia.NewsCategoryCipSoft,
+				Type:     tibia.NewsTypeNews,
+				Title:    "Tibia's 26th Anniversary",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(archive, want) {
+		t.Errorf("Wrong archive\nwant: %+v\ngot: %+v", want, archive)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "latestnews.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	begin := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, args := range []Args{
+		{},
+		{End: begin},
+		{Begin: begin, End: begin.AddDate(0, 0, -1)},
+	} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestSearchForm(t *testing.T) {
+	p := Parser{}
+
+	for _, tc := range []struct {
+		name string
+		args Args
+		want url.Values
+	}{
+		{
+			name: "all",
+			args: Args{
+				Begin: time.Date(2022, time.December, 5, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.July, 31, 0, 0, 0, 0, time.UTC),
+			},
+			want: url.Values{
+				"filter_begin_day":   {"5"},
+				"filter_begin_month": {"12"},
+				"filter_begin_year":  {"2022"},
+				"filter_end_day":     {"31"},
+				"filter_end_month":   {"7"},
+				"filter_end_year":    {"2023"},
+				"filter_cipsoft":     {"cipsoft"},
+				"filter_community":   {"community"},
+				"filter_development": {"development"},
+				"filter_support":     {"support"},
+				"filter_technical":   {"technical"},
+				"filter_news":        {"news"},
+				"filter_ticker":      {"ticker"},
+				"filter_article":     {"article"},
+			},
+		},
+		{
+			name: "filtered",
+			args: Args{
+				Begin: time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC),
+				Categories: []tibia.NewsCategory{
+					tibia.NewsCategoryDevelopment,
+				},
+				Types: []tibia.NewsType{
+					tibia.NewsTypeNews, tibia.NewsTypeFeaturedArticle,
+				},
+			},
+			want: url.Values{
+				"filter_begin_day":   {"1"},
+				"filter_begin_month": {"7"},
+				"filter_begin_year":  {"2023"},
+				"filter_end_day":     {"1"},
+				"filter_end_month":   {"7"},
+				"filter_end_year":    {"2023"},
+				"filter_development": {"development"},
+				"filter_news":        {"news"},
+				"filter_article":     {"article"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := p.searchForm(tc.args)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Wrong form\nwant: %v\ngot: %v", tc.want, got)
+			}
+		})
+	}
+}
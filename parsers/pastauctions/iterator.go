@@ -0,0 +1,94 @@
+package pastauctions
+
+import (
+	"context"
+
+	"github.com/phenpessoa/tibia-crawler/internal/pager"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// PageError is returned by Iterator when a page of the auction history could
+// not be parsed.
+//
+// The crawl can be resumed from the failed page by creating a new Iterator
+// with Args.Page set to Page.
+type PageError = pager.PageError
+
+// Iterator walks all the pages of the auction history, starting at Args.Page,
+// until the last page is reached.
+//
+// Pages are only fetched once all the auctions of the previous page were
+// consumed, and every request goes through the same ctx and Options, so
+// cancelling ctx stops the crawl and the RateLimiter is honored between pages.
+//
+// Example usage:
+//
+//	it := pastauctions.NewIterator(ctx, args, opts)
+//	for it.Next() {
+//		auction := it.Auction()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		var pageErr *pastauctions.PageError
+//		if errors.As(err, &pageErr) {
+//			// Resume later with args.Page = pageErr.Page.
+//		}
+//	}
+type Iterator struct {
+	pager *pager.Iterator[tibia.PastAuction]
+}
+
+// NewIterator returns an Iterator for the auction history described by args.
+//
+// args.Page is the first page to be fetched. If it is 0, the crawl starts at
+// the first page.
+func NewIterator(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) *Iterator {
+	var p Parser
+
+	fetch := func(page int) ([]tibia.PastAuction, bool, error) {
+		args.Page = page
+
+		history, err := p.Parse(ctx, args, opts)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return history.Auctions, page >= history.TotalPages, nil
+	}
+
+	return &Iterator{
+		pager: pager.New("pastauctions", args.Page, fetch),
+	}
+}
+
+// Next advances the iterator to the next auction, fetching the next page if
+// needed. It returns false when there are no more auctions or an error
+// happened, in which case Err reports it.
+func (it *Iterator) Next() bool {
+	return it.pager.Next()
+}
+
+// Auction returns the current auction.
+//
+// Auction must only be called after a call to Next returned true.
+func (it *Iterator) Auction() tibia.PastAuction {
+	return it.pager.Item()
+}
+
+// Page returns the page the current auction is from.
+func (it *Iterator) Page() int {
+	return it.pager.Page()
+}
+
+// Err returns the error that stopped the iterator, if any.
+//
+// Errors returned while fetching or parsing a page are wrapped in a
+// *PageError.
+func (it *Iterator) Err() error {
+	return it.pager.Err()
+}
@@ -0,0 +1,79 @@
+package pastauctions
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+// TestIterator only checks that Iterator is wired to Parser, the paging
+// itself is covered by the internal/pager tests.
+func TestIterator(t *testing.T) {
+	data := static.MustRead(t, "pastauctions.html")
+
+	var queries []url.Values
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.Query())
+			_, _ = w.Write([]byte(data))
+		},
+	))
+	defer srv.Close()
+
+	baseURL := parsers.BaseURL
+	parsers.BaseURL = srv.URL
+	defer func() { parsers.BaseURL = baseURL }()
+
+	// The fixture reports two pages, so starting at the second one only
+	// fetches a single page.
+	it := NewIterator(context.Background(), Args{Page: 2}, parsers.Options{})
+
+	var outcomes []tibia.AuctionOutcome
+	for it.Next() {
+		outcomes = append(outcomes, it.Auction().Outcome)
+		if it.Page() != 2 {
+			t.Errorf("Wrong page\nwant: %d\ngot: %d", 2, it.Page())
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantOutcomes := []tibia.AuctionOutcome{
+		tibia.AuctionOutcomeSold,
+		tibia.AuctionOutcomeNotSold,
+		tibia.AuctionOutcomeCancelled,
+	}
+	if len(outcomes) != len(wantOutcomes) {
+		t.Fatalf("Wrong auctions\nwant: %v\ngot: %v", wantOutcomes, outcomes)
+	}
+	for i := range outcomes {
+		if outcomes[i] != wantOutcomes[i] {
+			t.Errorf(
+				"Wrong auction %d\nwant: %s\ngot: %s",
+				i, wantOutcomes[i], outcomes[i],
+			)
+		}
+	}
+
+	if len(queries) != 1 {
+		t.Fatalf("Wrong amount of requests\nwant: %d\ngot: %d", 1, len(queries))
+	}
+
+	q := queries[0]
+	if got := q.Get("subtopic"); got != "pastcharactertrades" {
+		t.Errorf(
+			"Wrong subtopic\nwant: %s\ngot: %s", "pastcharactertrades", got,
+		)
+	}
+	if got := q.Get("currentpage"); got != "2" {
+		t.Errorf("Wrong currentpage\nwant: %s\ngot: %s", "2", got)
+	}
+}
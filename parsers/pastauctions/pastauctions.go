@@ -0,0 +1,161 @@
+// Package pastauctions provides an implementation of the Parser interface for
+// parsing a page of the auction history from the tibia.com Char Bazaar.
+//
+// To use the pastauctions package, create an instance of the Parser struct,
+// which implements the Parser interface.
+// The Parse method can then be called with the desired filters to fetch the
+// HTML content from the Char Bazaar page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// To walk every page of the auction history, see Iterator.
+package pastauctions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/bazaar"
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/parsers/auctions"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/charactertrade/?subtopic=pastcharactertrades"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the pastauctions endpoint.
+	contentLength = 250000
+)
+
+var _ parsers.Parser[Args, tibia.PastAuctions] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing a page of the auction history from the tibia.com Char Bazaar.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Filters are the same filters used by the current auctions.
+	auctions.Filters
+
+	// Page is the page to be parsed, starting at 1.
+	//
+	// If Page is 0, the first page is parsed. Page must not be negative.
+	// Otherwise, parsers.ErrInvalidArgs is returned.
+	Page int
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.PastAuctions, error) {
+	if err := args.Validate(); err != nil {
+		return tibia.PastAuctions{}, fmt.Errorf("pastauctions: %w", err)
+	}
+
+	if args.Page < 0 {
+		return tibia.PastAuctions{}, fmt.Errorf(
+			"pastauctions: invalid page %d: %w",
+			args.Page, parsers.ErrInvalidArgs,
+		)
+	}
+
+	if args.Page == 0 {
+		args.Page = 1
+	}
+
+	data, err := fetch.Get(ctx, p.pastAuctionsURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.PastAuctions{}, fmt.Errorf("pastauctions: %w", err)
+	}
+
+	history, err := p.parse(data, args)
+	if err != nil {
+		return tibia.PastAuctions{}, fmt.Errorf(
+			"pastauctions: failed to parse body: %w", err,
+		)
+	}
+
+	return history, nil
+}
+
+func (p *Parser) pastAuctionsURL(args Args) string {
+	vals := args.Values()
+	vals.Set("currentpage", strconv.Itoa(args.Page))
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	pastAuctionsCaption = "Auction History"
+
+	cancelledIndexer = "cancel"
+)
+
+func (p *Parser) parse(data string, args Args) (tibia.PastAuctions, error) {
+	history := tibia.PastAuctions{
+		Page: args.Page,
+	}
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return history, fmt.Errorf("pastauctions: %w", err)
+	}
+
+	table, ok := scrape.Table(content, pastAuctionsCaption)
+	if !ok {
+		return history, fmt.Errorf("pastauctions: %w", parsers.ErrNotFound)
+	}
+
+	cards, err := bazaar.ReadAuctions(table)
+	if err != nil {
+		return history, fmt.Errorf("pastauctions: %w", err)
+	}
+
+	for _, card := range cards {
+		history.Auctions = append(history.Auctions, tibia.PastAuction{
+			AuctionOverview: card.Overview,
+			Outcome:         p.readOutcome(card),
+		})
+	}
+
+	history.TotalResults, history.TotalPages, err = bazaar.ReadResults(
+		content,
+	)
+	if err != nil {
+		return history, fmt.Errorf("pastauctions: %w", err)
+	}
+
+	return history, nil
+}
+
+// readOutcome reads how the auction of card ended.
+//
+// tibia.com labels the bid of sold auctions as "Winning Bid", while auctions
+// that were not sold keep their "Minimum Bid". Cancelled auctions are told
+// apart by their info text, i.e. "cancelled".
+func (p *Parser) readOutcome(card bazaar.Card) tibia.AuctionOutcome {
+	switch {
+	case strings.Contains(strings.ToLower(card.Info), cancelledIndexer):
+		return tibia.AuctionOutcomeCancelled
+	case card.Overview.BidStatus == tibia.AuctionBidStatusWinning:
+		return tibia.AuctionOutcomeSold
+	default:
+		return tibia.AuctionOutcomeNotSold
+	}
+}
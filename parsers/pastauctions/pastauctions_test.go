@@ -0,0 +1,165 @@
+package pastauctions
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/parsers/auctions"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	data := static.MustRead(t, "pastauctions.html")
+
+	history, err := p.parse(data, Args{Page: 1})
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	cest := time.FixedZone("CEST", 2*60*60)
+	at := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2023, month, day, hour, min, 0, 0, cest)
+	}
+
+	want := tibia.PastAuctions{
+		Page:         1,
+		TotalPages:   2,
+		TotalResults: 30,
+		Auctions: []tibia.PastAuction{
+			{
+				AuctionOverview: tibia.AuctionOverview{
+					ID:        1100001,
+					Character: "Sandy Andersen",
+					Level:     301,
+					Vocation:  tibia.VocationRoyalPaladin,
+					World:     "Antica",
+					Start:     at(time.June, 28, 12, 0),
+					End:       at(time.July, 3, 12, 0),
+					Bid:       9800,
+					BidStatus: tibia.AuctionBidStatusWinning,
+				},
+				Outcome: tibia.AuctionOutcomeSold,
+			},
+			{
+				AuctionOverview: tibia.AuctionOverview{
+					ID:        1100002,
+					Character: "Nandor",
+					Level:     20,
+					Vocation:  tibia.VocationKnight,
+					World:     "Bona",
+					Start:     at(time.June, 29, 16, 45),
+					End:       at(time.July, 4, 16, 0),
+					Bid:       300,
+					BidStatus: tibia.AuctionBidStatusMinimum,
+				},
+				Outcome: tibia.AuctionOutcomeNotSold,
+			},
+			{
+				AuctionOverview: tibia.AuctionOverview{
+					ID:        1100003,
+					Character: "Torbjörn",
+					Level:     250,
+					Vocation:  tibia.VocationMasterSorcerer,
+					World:     "Antica",
+					Start:     at(time.June, 30, 8, 20),
+					End:       at(time.July, 5, 8, 0),
+					Bid:       4000,
+					BidStatus: tibia.AuctionBidStatusMinimum,
+				},
+				Outcome: tibia.AuctionOutcomeCancelled,
+			},
+		},
+	}
+
+	if len(history.Auctions) != len(want.Auctions) {
+		t.Fatalf(
+			"Wrong auctions count\nwant: %d\ngot: %d",
+			len(want.Auctions), len(history.Auctions),
+		)
+	}
+
+	// The times are compared with Equal, as their locations differ.
+	for i, got := range history.Auctions {
+		w := want.Auctions[i]
+		if !got.Start.Equal(w.Start) || !got.End.Equal(w.End) {
+			t.Errorf(
+				"Wrong times of %s\nwant: %s %s\ngot: %s %s",
+				w.Character, w.Start, w.End, got.Start, got.End,
+			)
+		}
+		got.Start, got.End = w.Start, w.End
+		history.Auctions[i] = got
+	}
+
+	if !reflect.DeepEqual(history, want) {
+		t.Errorf("Wrong auctions\nwant: %+v\ngot: %+v", want, history)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "auctions.html"), Args{})
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{
+		{Page: -1},
+		{Filters: auctions.Filters{World: "invalid world 1"}},
+		{Filters: auctions.Filters{MinLevel: 200, MaxLevel: 100}},
+	} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error for %+v\nwant: %s\ngot: %v",
+				args, parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestPastAuctionsURL(t *testing.T) {
+	p := Parser{}
+
+	u, err := url.Parse(p.pastAuctionsURL(Args{
+		Filters: auctions.Filters{
+			World:    "Antica",
+			Vocation: tibia.VocationKnight,
+			MinLevel: 100,
+			Order:    tibia.AuctionOrderBid,
+		},
+		Page: 2,
+	}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic":              {"pastcharactertrades"},
+		"filter_world":          {"Antica"},
+		"filter_profession":     {"3"},
+		"filter_levelrangefrom": {"100"},
+		"order_column":          {"100"},
+		"order_direction":       {"1"},
+		"currentpage":           {"2"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
@@ -0,0 +1,241 @@
+// Package spell provides an implementation of the Parser interface for
+// parsing information about a single spell from the tibia.com Spells Library
+// page.
+//
+// To use the spell package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the ID of the spell to fetch the
+// HTML content from the Spells Library page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// The IDs of the spells can be found with the spells package.
+package spell
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/library/?subtopic=spells"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the spell endpoint.
+	contentLength = 40000
+)
+
+var _ parsers.Parser[Args, tibia.Spell] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing information about a single spell from the tibia.com Spells Library
+// page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// ID is the identifier tibia.com uses for the spell, i.e.
+	// "ultimatehealing".
+	//
+	// ID must not be blank. Otherwise, parsers.ErrInvalidArgs is returned.
+	ID string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com does not display the spell, which happens when the ID does not
+// exist, an error wrapping parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Spell, error) {
+	if strings.TrimSpace(args.ID) == "" {
+		return tibia.Spell{}, fmt.Errorf(
+			"spell: invalid id %q: %w", args.ID, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.spellURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.Spell{}, fmt.Errorf("spell: %w", err)
+	}
+
+	spell, err := p.parse(data)
+	if err != nil {
+		return tibia.Spell{}, fmt.Errorf("spell: failed to parse body: %w", err)
+	}
+
+	// The ID is not part of the spell page.
+	spell.ID = args.ID
+
+	return spell, nil
+}
+
+func (p *Parser) spellURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("spell", args.ID)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	spellCaption   = "Spell Information"
+	sellersCaption = "Sold by"
+
+	nameLabel        = "Name"
+	formulaLabel     = "Formula"
+	vocationLabel    = "Vocation"
+	groupLabel       = "Group"
+	typeLabel        = "Type"
+	cooldownLabel    = "Cooldown"
+	soulPointsLabel  = "Soul Points"
+	manaLabel        = "Mana"
+	premiumLabel     = "Premium"
+	levelLabel       = "Exp Lvl"
+	priceLabel       = "Price"
+	descriptionLabel = "Description"
+
+	groupCooldownIndexer    = " (Group: "
+	endGroupCooldownIndexer = ")"
+
+	headerIndexer = "<b>"
+
+	vocationSeparator = ", "
+
+	yesVal = "yes"
+)
+
+func (p *Parser) parse(data string) (tibia.Spell, error) {
+	var spell tibia.Spell
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return spell, fmt.Errorf("spell: %w", err)
+	}
+
+	table, ok := scrape.Table(content, spellCaption)
+	if !ok {
+		return spell, fmt.Errorf("spell: %w", parsers.ErrNotFound)
+	}
+
+	rows := scrape.LabeledRows(table)
+
+	spell.Name = scrape.Text(rows[nameLabel])
+	if spell.Name == "" {
+		return spell, fmt.Errorf("spell: name not found")
+	}
+
+	spell.Formula = scrape.Text(rows[formulaLabel])
+	spell.Description = scrape.Text(rows[descriptionLabel])
+	spell.Premium = scrape.Text(rows[premiumLabel]) == yesVal
+
+	spell.Vocations, err = p.readVocations(scrape.Text(rows[vocationLabel]))
+	if err != nil {
+		return spell, fmt.Errorf("spell: %s vocations: %w", spell.Name, err)
+	}
+
+	spell.Group, err = tibia.SpellGroupFromString(scrape.Text(rows[groupLabel]))
+	if err != nil {
+		return spell, fmt.Errorf("spell: %s group: %w", spell.Name, err)
+	}
+
+	spell.Type, err = tibia.SpellTypeFromString(scrape.Text(rows[typeLabel]))
+	if err != nil {
+		return spell, fmt.Errorf("spell: %s type: %w", spell.Name, err)
+	}
+
+	cooldown := scrape.Text(rows[cooldownLabel])
+	if err := p.readCooldown(&spell, cooldown); err != nil {
+		return spell, fmt.Errorf("spell: %s: %w", spell.Name, err)
+	}
+
+	ints := map[string]*int{
+		soulPointsLabel: &spell.SoulPoints,
+		manaLabel:       &spell.Mana,
+		levelLabel:      &spell.Level,
+		priceLabel:      &spell.Price,
+	}
+	for label, field := range ints {
+		*field, err = scrape.Int(rows[label])
+		if err != nil {
+			return spell, fmt.Errorf(
+				"spell: %s %s: %w", spell.Name, label, err,
+			)
+		}
+	}
+
+	if sellers, ok := scrape.Table(content, sellersCaption); ok {
+		spell.Sellers = p.readSellers(sellers)
+	}
+
+	return spell, nil
+}
+
+// readVocations reads lines such as "Druid, Sorcerer".
+func (p *Parser) readVocations(s string) ([]tibia.Vocation, error) {
+	var vocations []tibia.Vocation
+	for _, name := range strings.Split(s, vocationSeparator) {
+		vocation, err := tibia.VocationFromString(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		vocations = append(vocations, vocation)
+	}
+	return vocations, nil
+}
+
+// readCooldown reads lines such as "2s (Group: 1s)".
+func (p *Parser) readCooldown(spell *tibia.Spell, s string) error {
+	cooldown, rest, ok := strings.Cut(s, groupCooldownIndexer)
+	if !ok {
+		return fmt.Errorf("group cooldown not found in %q", s)
+	}
+
+	var err error
+
+	spell.Cooldown, err = time.ParseDuration(cooldown)
+	if err != nil {
+		return fmt.Errorf("cooldown: %w", err)
+	}
+
+	spell.GroupCooldown, err = time.ParseDuration(
+		strings.TrimSuffix(rest, endGroupCooldownIndexer),
+	)
+	if err != nil {
+		return fmt.Errorf("group cooldown: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Parser) readSellers(table string) []tibia.SpellSeller {
+	var sellers []tibia.SpellSeller
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 2 || strings.Contains(cells[0], headerIndexer) {
+			continue
+		}
+
+		sellers = append(sellers, tibia.SpellSeller{
+			NPC:  scrape.Text(cells[0]),
+			City: scrape.Text(cells[1]),
+		})
+	}
+	return sellers
+}
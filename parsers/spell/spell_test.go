@@ -0,0 +1,94 @@
+package spell
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	spell, err := p.parse(static.MustRead(t, "spell.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	want := tibia.Spell{
+		SpellOverview: tibia.SpellOverview{
+			Name:    "Ultimate Healing",
+			Formula: "exura vita",
+			Vocations: []tibia.Vocation{
+				tibia.VocationDruid, tibia.VocationSorcerer,
+			},
+			Group: tibia.SpellGroupHealing,
+			Type:  tibia.SpellTypeInstant,
+			Level: 30,
+			Mana:  160,
+			Price: 1000,
+		},
+		Description: "Heals a lot of hitpoints.\n" +
+			"The amount healed depends on your level and magic level.",
+		Cooldown:      time.Second,
+		GroupCooldown: time.Second,
+		Sellers: []tibia.SpellSeller{
+			{NPC: "Elathriel", City: "Ab'Dendriel"},
+			{NPC: "Padreia", City: "Carlin"},
+			{NPC: "Rahkem", City: "Ankrahmun"},
+		},
+	}
+
+	if !reflect.DeepEqual(spell, want) {
+		t.Errorf("Wrong spell\nwant: %+v\ngot: %+v", want, spell)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "spells.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	for _, args := range []Args{{}, {ID: " "}} {
+		_, err := p.Parse(context.Background(), args, parsers.Options{})
+		if !errors.Is(err, parsers.ErrInvalidArgs) {
+			t.Errorf(
+				"unexpected error\nwant: %s\ngot: %v",
+				parsers.ErrInvalidArgs, err,
+			)
+		}
+	}
+}
+
+func TestSpellURL(t *testing.T) {
+	p := Parser{}
+
+	u, err := url.Parse(p.spellURL(Args{ID: "ultimatehealing"}))
+	if err != nil {
+		t.Fatalf("failed to parse url: %s", err)
+	}
+
+	want := url.Values{
+		"subtopic": {"spells"},
+		"spell":    {"ultimatehealing"},
+	}
+
+	if got := u.Query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong query\nwant: %v\ngot: %v", want, got)
+	}
+}
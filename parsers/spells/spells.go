@@ -0,0 +1,233 @@
+// Package spells provides an implementation of the Parser interface for
+// parsing the list of spells from the tibia.com Spells Library page.
+//
+// To use the spells package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the desired filters to fetch the
+// HTML content from the Spells Library page, parse it, and return the parsed
+// data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+//
+// To parse the details of a single spell, see the spell package.
+package spells
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/library/?subtopic=spells"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the spells endpoint.
+	contentLength = 150000
+)
+
+var _ parsers.Parser[Args, []tibia.SpellOverview] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the list of spells from the tibia.com Spells Library page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Vocation is the vocation whose spells will be parsed.
+	//
+	// tibia.com does not filter by promoted vocations, so the spells of the
+	// base vocation are parsed instead.
+	//
+	// If Vocation is tibia.VocationAll, spells of every vocation are included.
+	// If Vocation is tibia.VocationNone, parsers.ErrInvalidArgs is returned.
+	Vocation tibia.Vocation
+
+	// Group is the group of the spells to filter by.
+	//
+	// If Group is nil, spells of every group are included.
+	Group *tibia.SpellGroup
+
+	// Type is the type of the spells to filter by.
+	//
+	// If Type is nil, spells of every type are included.
+	Type *tibia.SpellType
+
+	// Premium is whether to include only premium spells or only free spells.
+	//
+	// If Premium is nil, both premium and free spells are included.
+	Premium *bool
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) ([]tibia.SpellOverview, error) {
+	if args.Vocation == tibia.VocationNone {
+		return nil, fmt.Errorf(
+			"spells: invalid vocation %q: %w",
+			args.Vocation, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.spellsURL(args), opts, contentLength)
+	if err != nil {
+		return nil, fmt.Errorf("spells: %w", err)
+	}
+
+	spells, err := p.parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("spells: failed to parse body: %w", err)
+	}
+
+	return spells, nil
+}
+
+func (p *Parser) spellsURL(args Args) string {
+	vals := url.Values{}
+	if args.Vocation != tibia.VocationAll {
+		// Unlike other tibia.com pages, the spells page filters vocations by
+		// name, not by their ID.
+		base, _ := tibia.VocationFromInt(args.Vocation.QueryID())
+		vals.Set("vocation", base.String())
+	}
+	if args.Group != nil {
+		vals.Set(args.Group.QueryKey(), args.Group.QueryVal())
+	}
+	if args.Type != nil {
+		vals.Set(args.Type.QueryKey(), args.Type.QueryVal())
+	}
+	if args.Premium != nil {
+		premium := noVal
+		if *args.Premium {
+			premium = yesVal
+		}
+		vals.Set("premium", premium)
+	}
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	spellsCaption = "Spells"
+
+	idIndexer    = "spell="
+	endIDIndexer = "&"
+
+	formulaIndexer    = " ("
+	endFormulaIndexer = ")"
+
+	vocationSeparator = ", "
+
+	yesVal = "yes"
+	noVal  = "no"
+)
+
+func (p *Parser) parse(data string) ([]tibia.SpellOverview, error) {
+	content, err := scrape.Content(data)
+	if err != nil {
+		return nil, fmt.Errorf("spells: %w", err)
+	}
+
+	table, ok := scrape.Table(content, spellsCaption)
+	if !ok {
+		return nil, fmt.Errorf("spells: %w", parsers.ErrNotFound)
+	}
+
+	var spells []tibia.SpellOverview
+	for _, row := range scrape.Rows(table) {
+		cells := scrape.Cells(row)
+		if len(cells) < 8 {
+			// Rows such as "No spells found.".
+			continue
+		}
+
+		// Skip the header row.
+		if !strings.Contains(cells[0], idIndexer) {
+			continue
+		}
+
+		spell, err := p.readSpell(cells)
+		if err != nil {
+			return nil, fmt.Errorf("spells: %w", err)
+		}
+
+		spells = append(spells, spell)
+	}
+
+	return spells, nil
+}
+
+func (p *Parser) readSpell(cells []string) (tibia.SpellOverview, error) {
+	var spell tibia.SpellOverview
+
+	spell.ID, _, _ = scrape.Between(cells[0], idIndexer, endIDIndexer)
+
+	// The name cell is made of the name and the formula, i.e.
+	// "Ultimate Healing (exura vita)".
+	name := scrape.Text(cells[0])
+	idx := strings.LastIndex(name, formulaIndexer)
+	if idx == -1 || !strings.HasSuffix(name, endFormulaIndexer) {
+		return spell, fmt.Errorf("%s: formula not found", name)
+	}
+	spell.Name = name[:idx]
+	spell.Formula = strings.TrimSuffix(
+		name[idx+len(formulaIndexer):], endFormulaIndexer,
+	)
+
+	var err error
+
+	spell.Vocations, err = p.readVocations(scrape.Text(cells[1]))
+	if err != nil {
+		return spell, fmt.Errorf("%s vocations: %w", spell.Name, err)
+	}
+
+	spell.Group, err = tibia.SpellGroupFromString(scrape.Text(cells[2]))
+	if err != nil {
+		return spell, fmt.Errorf("%s group: %w", spell.Name, err)
+	}
+
+	spell.Type, err = tibia.SpellTypeFromString(scrape.Text(cells[3]))
+	if err != nil {
+		return spell, fmt.Errorf("%s type: %w", spell.Name, err)
+	}
+
+	ints := []*int{&spell.Level, &spell.Mana, &spell.Price}
+	for i, field := range ints {
+		*field, err = scrape.Int(scrape.Text(cells[i+4]))
+		if err != nil {
+			return spell, fmt.Errorf("%s column %d: %w", spell.Name, i+4, err)
+		}
+	}
+
+	spell.Premium = scrape.Text(cells[7]) == yesVal
+
+	return spell, nil
+}
+
+// readVocations reads lines such as "Druid, Sorcerer".
+func (p *Parser) readVocations(s string) ([]tibia.Vocation, error) {
+	var vocations []tibia.Vocation
+	for _, name := range strings.Split(s, vocationSeparator) {
+		vocation, err := tibia.VocationFromString(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		vocations = append(vocations, vocation)
+	}
+	return vocations, nil
+}
@@ -0,0 +1,179 @@
+package spells
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	p := Parser{}
+
+	spells, err := p.parse(static.MustRead(t, "spells.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	druidSorcerer := []tibia.Vocation{
+		tibia.VocationDruid, tibia.VocationSorcerer,
+	}
+
+	want := []tibia.SpellOverview{
+		{
+			ID:        "annihilation",
+			Name:      "Annihilation",
+			Formula:   "exori gran ico",
+			Vocations: []tibia.Vocation{tibia.VocationKnight},
+			Group:     tibia.SpellGroupAttack,
+			Type:      tibia.SpellTypeInstant,
+			Level:     110,
+			Mana:      300,
+			Price:     20000,
+			Premium:   true,
+		},
+		{
+			ID:        "greatfireball",
+			Name:      "Great Fireball",
+			Formula:   "adori mas flam",
+			Vocations: []tibia.Vocation{tibia.VocationSorcerer},
+			Group:     tibia.SpellGroupAttack,
+			Type:      tibia.SpellTypeRune,
+			Level:     30,
+			Mana:      530,
+			Price:     1200,
+		},
+		{
+			ID:        "heavymagicmissile",
+			Name:      "Heavy Magic Missile",
+			Formula:   "adori vis",
+			Vocations: druidSorcerer,
+			Group:     tibia.SpellGroupAttack,
+			Type:      tibia.SpellTypeRune,
+			Level:     25,
+			Mana:      350,
+			Price:     1500,
+		},
+		{
+			ID:        "masshealing",
+			Name:      "Mass Healing",
+			Formula:   "exura gran mas res",
+			Vocations: []tibia.Vocation{tibia.VocationDruid},
+			Group:     tibia.SpellGroupHealing,
+			Type:      tibia.SpellTypeInstant,
+			Level:     36,
+			Mana:      150,
+			Price:     2200,
+			Premium:   true,
+		},
+		{
+			ID:        "ultimatehealing",
+			Name:      "Ultimate Healing",
+			Formula:   "exura vita",
+			Vocations: druidSorcerer,
+			Group:     tibia.SpellGroupHealing,
+			Type:      tibia.SpellTypeInstant,
+			Level:     30,
+			Mana:      160,
+			Price:     1000,
+		},
+	}
+
+	if !reflect.DeepEqual(spells, want) {
+		t.Errorf("Wrong spells\nwant: %+v\ngot: %+v", want, spells)
+	}
+}
+
+func TestParserCanCast(t *testing.T) {
+	p := Parser{}
+
+	spells, err := p.parse(static.MustRead(t, "spells.html"))
+	if err != nil {
+		t.Fatalf("failed to parse data: %s\n%#v\n", err, err)
+	}
+
+	var got []string
+	for _, spell := range spells {
+		if spell.CanCast(tibia.VocationElderDruid, 80) {
+			got = append(got, spell.ID)
+		}
+	}
+
+	want := []string{"heavymagicmissile", "masshealing", "ultimatehealing"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong castable spells\nwant: %v\ngot: %v", want, got)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.parse(static.MustRead(t, "spell.html"))
+	if !errors.Is(err, parsers.ErrNotFound) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrNotFound, err,
+		)
+	}
+}
+
+func TestParserInvalidArgs(t *testing.T) {
+	p := Parser{}
+
+	_, err := p.Parse(
+		context.Background(),
+		Args{Vocation: tibia.VocationNone},
+		parsers.Options{},
+	)
+	if !errors.Is(err, parsers.ErrInvalidArgs) {
+		t.Errorf(
+			"unexpected error\nwant: %s\ngot: %v", parsers.ErrInvalidArgs, err,
+		)
+	}
+}
+
+func TestSpellsURL(t *testing.T) {
+	p := Parser{}
+
+	group := tibia.SpellGroupHealing
+	typ := tibia.SpellTypeInstant
+	premium := false
+
+	for _, tc := range []struct {
+		args Args
+		want url.Values
+	}{
+		{
+			args: Args{},
+			want: url.Values{"subtopic": {"spells"}},
+		},
+		{
+			args: Args{
+				Vocation: tibia.VocationElderDruid,
+				Group:    &group,
+				Type:     &typ,
+				Premium:  &premium,
+			},
+			want: url.Values{
+				"subtopic": {"spells"},
+				"vocation": {"Druid"},
+				"group":    {"Healing"},
+				"type":     {"Instant"},
+				"premium":  {"no"},
+			},
+		},
+	} {
+		u, err := url.Parse(p.spellsURL(tc.args))
+		if err != nil {
+			t.Fatalf("failed to parse url: %s", err)
+		}
+
+		if got := u.Query(); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Wrong query\nwant: %v\ngot: %v", tc.want, got)
+		}
+	}
+}
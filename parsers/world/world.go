@@ -0,0 +1,292 @@
+// Package world provides an implementation of the Parser interface for
+// parsing information about a single game world, including its online
+// players, from the tibia.com World Information page.
+//
+// To use the world package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called with the name of the world to fetch the
+// HTML content from the World Information page, parse it, and return the
+// parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package world
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=worlds"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the world endpoint.
+	contentLength = 250000
+)
+
+var _ parsers.Parser[Args, tibia.World] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing information about a single game world from the tibia.com World
+// Information page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface.
+type Args struct {
+	// Name is the name of the world to be parsed.
+	//
+	// Name must be a valid world name, see tibia.IsWorldNameValid.
+	// Otherwise, parsers.ErrInvalidArgs is returned.
+	Name string
+}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+//
+// If tibia.com reports that the world does not exist, an error wrapping
+// parsers.ErrNotFound is returned.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.World, error) {
+	if !tibia.IsWorldNameValid(args.Name) {
+		return tibia.World{}, fmt.Errorf(
+			"world: invalid name %q: %w", args.Name, parsers.ErrInvalidArgs,
+		)
+	}
+
+	data, err := fetch.Get(ctx, p.worldURL(args), opts, contentLength)
+	if err != nil {
+		return tibia.World{}, fmt.Errorf("world: %w", err)
+	}
+
+	if strings.Contains(data, notFoundIndexer) {
+		return tibia.World{}, fmt.Errorf(
+			"world: %q: %w", args.Name, parsers.ErrNotFound,
+		)
+	}
+
+	world, err := p.parse(data)
+	if err != nil {
+		return tibia.World{}, fmt.Errorf(
+			"world: failed to parse body: %w", err,
+		)
+	}
+
+	// The world name is not part of the information table.
+	world.Name = args.Name
+
+	return world, nil
+}
+
+func (p *Parser) worldURL(args Args) string {
+	vals := url.Values{}
+	vals.Set("world", args.Name)
+	return p.URL() + "&" + vals.Encode()
+}
+
+const (
+	notFoundIndexer = `World with this name doesn't exist!`
+
+	infoCaption    = "World Information"
+	playersCaption = "Players Online"
+
+	creationDateLayout = "01/06"
+
+	recordIndexer        = " players (on "
+	endRecordTimeIndexer = ")"
+
+	onlineStatus = "Online"
+
+	noQuestTitles = "This game world currently has no title."
+
+	battleEyeSinceIndexer = "Protected by BattlEye since "
+	battleEyeRelease      = "its release"
+	battleEyeUnprotected  = "Not protected by BattlEye."
+
+	premiumType             = "premium"
+	transferTypeLocked      = "locked"
+	transferTypeBlocked     = "blocked"
+	experimentalWorldType   = "Experimental"
+	tournamentWorldTypeName = "Tournament"
+)
+
+func (p *Parser) parse(data string) (tibia.World, error) {
+	var world tibia.World
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return world, fmt.Errorf("world: %w", err)
+	}
+
+	info, ok := scrape.Table(content, infoCaption)
+	if !ok {
+		return world, fmt.Errorf("world: information table not found")
+	}
+
+	for label, value := range scrape.LabeledRows(info) {
+		if err := p.readInfoRow(&world, label, value); err != nil {
+			return world, fmt.Errorf("world: %s: %w", label, err)
+		}
+	}
+
+	if players, ok := scrape.Table(content, playersCaption); ok {
+		world.OnlinePlayers, err = p.readPlayers(players)
+		if err != nil {
+			return world, fmt.Errorf("world: %w", err)
+		}
+	}
+
+	return world, nil
+}
+
+func (p *Parser) readInfoRow(world *tibia.World, label, value string) error {
+	text := scrape.Text(value)
+
+	switch label {
+	case "Status":
+		if text == onlineStatus {
+			world.Status = tibia.OnlineStatusOnline
+		}
+	case "Players Online":
+		n, err := scrape.Int(text)
+		if err != nil {
+			return err
+		}
+		world.PlayersOnline = n
+	case "Online Record":
+		record, recordTime, ok := strings.Cut(text, recordIndexer)
+		if !ok {
+			return fmt.Errorf("record time not found")
+		}
+
+		n, err := scrape.Int(record)
+		if err != nil {
+			return err
+		}
+		world.RecordPlayers = n
+
+		t, err := scrape.DateTime(
+			strings.TrimSuffix(recordTime, endRecordTimeIndexer),
+		)
+		if err != nil {
+			return err
+		}
+		world.RecordTime = t
+	case "Creation Date":
+		t, err := time.Parse(creationDateLayout, text)
+		if err != nil {
+			return err
+		}
+		world.CreationDate = t
+	case "Location":
+		world.Location = text
+	case "PvP Type":
+		pvpType, err := tibia.PvPTypeFromString(text)
+		if err != nil {
+			return err
+		}
+		world.PvPType = pvpType
+	case "Premium Type":
+		world.PremiumOnly = text == premiumType
+	case "Transfer Type":
+		world.TransferLocked = text == transferTypeLocked
+		world.TransferBlocked = text == transferTypeBlocked
+	case "World Quest Titles":
+		if text == noQuestTitles {
+			return nil
+		}
+
+		for _, title := range strings.Split(text, ",") {
+			if title = strings.TrimSpace(title); title != "" {
+				world.QuestTitles = append(world.QuestTitles, title)
+			}
+		}
+	case "BattlEye Status":
+		return p.readBattleEye(world, text)
+	case "Game World Type":
+		world.Experimental = text == experimentalWorldType
+		world.Tournament = text == tournamentWorldTypeName
+	}
+
+	return nil
+}
+
+// readBattleEye reads statuses such as
+// "Protected by BattlEye since Aug 29 2017.".
+func (p *Parser) readBattleEye(world *tibia.World, text string) error {
+	if text == battleEyeUnprotected {
+		world.BattleEye = tibia.BattleEyeStatusUnprotected
+		return nil
+	}
+
+	since, ok := strings.CutPrefix(text, battleEyeSinceIndexer)
+	if !ok {
+		return fmt.Errorf("unknown battle eye status: %q", text)
+	}
+	since = strings.TrimSuffix(since, ".")
+
+	if since == battleEyeRelease {
+		world.BattleEye = tibia.BattleEyeStatusInitiallyProtected
+		return nil
+	}
+
+	t, err := scrape.Date(since)
+	if err != nil {
+		return err
+	}
+
+	world.BattleEye = tibia.BattleEyeStatusProtected
+	world.BattleEyeSince = t
+	return nil
+}
+
+func (p *Parser) readPlayers(table string) ([]tibia.OnlinePlayer, error) {
+	rows := scrape.Rows(table)
+	players := make([]tibia.OnlinePlayer, 0, len(rows))
+	for _, row := range rows {
+		cells := scrape.Cells(row)
+		if len(cells) < 3 {
+			continue
+		}
+
+		// Skip the header row.
+		if !strings.Contains(cells[0], "<a ") {
+			continue
+		}
+
+		player := tibia.OnlinePlayer{
+			Name: scrape.Text(cells[0]),
+		}
+
+		level, err := scrape.Int(cells[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s level: %w", player.Name, err)
+		}
+		player.Level = level
+
+		voc, err := tibia.VocationFromString(scrape.Text(cells[2]))
+		if err != nil {
+			return nil, fmt.Errorf("%s vocation: %w", player.Name, err)
+		}
+		player.Vocation = voc
+
+		players = append(players, player)
+	}
+	return players, nil
+}
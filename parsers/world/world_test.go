@@ -0,0 +1,111 @@
+package world
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	data := static.MustRead(t, "world.html")
+
+	p := Parser{}
+
+	world, err := p.parse(data)
+	if err != nil {
+		t.Errorf("failed to parse data: %s\n%#v\n", err, err)
+		return
+	}
+
+	want := tibia.World{
+		Status:        tibia.OnlineStatusOnline,
+		PlayersOnline: 5,
+		RecordPlayers: 2090,
+		RecordTime:    time.Date(2023, time.July, 6, 17, 40, 46, 0, time.UTC),
+		CreationDate:  time.Date(1997, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Location:      "Europe",
+		PvPType:       tibia.PvPTypeOpenPvP,
+		PremiumOnly:   true,
+		QuestTitles: []string{
+			"Rise of Devovorga", "Bewitched", "The Colours of Magic",
+		},
+		TransferLocked: true,
+		BattleEye:      tibia.BattleEyeStatusProtected,
+		BattleEyeSince: time.Date(2017, time.August, 29, 0, 0, 0, 0, time.UTC),
+		OnlinePlayers: []tibia.OnlinePlayer{
+			{
+				Name:     "Kharsek Valdor",
+				Level:    1174,
+				Vocation: tibia.VocationEliteKnight,
+			},
+			{
+				Name:     "Sandy Andersen",
+				Level:    301,
+				Vocation: tibia.VocationRoyalPaladin,
+			},
+			{
+				Name:     "Torbjörn",
+				Level:    8,
+				Vocation: tibia.VocationNone,
+			},
+			{
+				Name:     "Mage Apprentice",
+				Level:    45,
+				Vocation: tibia.VocationSorcerer,
+			},
+			{
+				Name:     "Flora Greenleaf",
+				Level:    999,
+				Vocation: tibia.VocationElderDruid,
+			},
+		},
+	}
+
+	if !world.RecordTime.Equal(want.RecordTime) {
+		t.Errorf(
+			"Wrong record time\nwant: %s\ngot: %s",
+			want.RecordTime, world.RecordTime,
+		)
+	}
+	world.RecordTime = want.RecordTime
+
+	if !reflect.DeepEqual(want, world) {
+		t.Errorf("Wrong world\nwant: %+v\ngot: %+v", want, world)
+	}
+}
+
+func TestParserNotFound(t *testing.T) {
+	data := static.MustRead(t, "world_notfound.html")
+	if !strings.Contains(data, notFoundIndexer) {
+		t.Errorf("not found page was not detected")
+	}
+
+	if strings.Contains(static.MustRead(t, "world.html"), notFoundIndexer) {
+		t.Errorf("world page was detected as not found")
+	}
+}
+
+func TestParserInvalidName(t *testing.T) {
+	p := Parser{}
+
+	for _, name := range []string{"", "antica", "An", "Antica2", "New World"} {
+		t.Run(name, func(t *testing.T) {
+			_, err := p.Parse(
+				context.Background(), Args{Name: name}, parsers.Options{},
+			)
+			if !errors.Is(err, parsers.ErrInvalidArgs) {
+				t.Errorf(
+					"unexpected error\nwant: %s\ngot: %v",
+					parsers.ErrInvalidArgs, err,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Package worlds provides an implementation of the Parser interface for
+// parsing the overview of all game worlds from the tibia.com Game Worlds page.
+//
+// To use the worlds package, create an instance of the Parser struct, which
+// implements the Parser interface.
+// The Parse method can then be called to fetch the HTML content from the
+// Game Worlds page, parse it, and return the parsed data.
+// Additionally, the URL method can be used to retrieve the specific tibia.com
+// endpoint being parsed.
+package worlds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/phenpessoa/tibia-crawler/internal/fetch"
+	"github.com/phenpessoa/tibia-crawler/internal/scrape"
+	"github.com/phenpessoa/tibia-crawler/parsers"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+const (
+	endpoint = "/community/?subtopic=worlds"
+
+	// contentLength is the aprox Content-Length of the data returned by
+	// the worlds endpoint.
+	contentLength = 90000
+)
+
+var _ parsers.Parser[Args, tibia.Worlds] = (*Parser)(nil)
+
+// Parser is an implementation of the Parser interface for
+// parsing the overview of all game worlds from the tibia.com Game Worlds page.
+//
+// Parser does not cache responses.
+type Parser struct{}
+
+// Args is used by Parser to implement the parsers.Parser interface, but it is
+// not used by this implementation.
+type Args struct{}
+
+// URL implements the parsers.Parser interface.
+func (p *Parser) URL() string {
+	return parsers.BaseURL + endpoint
+}
+
+// Parse implements the parsers.Parser interface.
+func (p *Parser) Parse(
+	ctx context.Context,
+	args Args,
+	opts parsers.Options,
+) (tibia.Worlds, error) {
+	data, err := fetch.Get(ctx, p.URL(), opts, contentLength)
+	if err != nil {
+		return tibia.Worlds{}, fmt.Errorf("worlds: %w", err)
+	}
+
+	worlds, err := p.parse(data)
+	if err != nil {
+		return tibia.Worlds{}, fmt.Errorf(
+			"worlds: failed to parse body: %w", err,
+		)
+	}
+
+	return worlds, nil
+}
+
+const (
+	summaryCaption    = "World Selection"
+	regularCaption    = "Regular Worlds"
+	tournamentCaption = "Tournament Worlds"
+
+	playersOnlineIndexer    = "Currently there are "
+	endPlayersOnlineIndexer = " players online"
+
+	recordIndexer        = "Overall Maximum: "
+	endRecordIndexer     = " players (on "
+	endRecordTimeIndexer = ")"
+
+	offlineWorld = "offline"
+
+	battleEyeInitialIndexer = "icon_battleyeinitial"
+	battleEyeIndexer        = "icon_battleye"
+	battleEyeSinceIndexer   = "Protected by BattlEye since "
+	endBattleEyeSince       = "."
+
+	premiumInfo         = "premium"
+	transferLockedInfo  = "transfer locked"
+	transferBlockedInfo = "blocked"
+	experimentalInfo    = "experimental"
+)
+
+func (p *Parser) parse(data string) (tibia.Worlds, error) {
+	var worlds tibia.Worlds
+
+	content, err := scrape.Content(data)
+	if err != nil {
+		return worlds, fmt.Errorf("worlds: %w", err)
+	}
+
+	if err := p.readSummary(&worlds, content); err != nil {
+		return worlds, fmt.Errorf("worlds: %w", err)
+	}
+
+	regular, ok := scrape.Table(content, regularCaption)
+	if !ok {
+		return worlds, fmt.Errorf("worlds: regular worlds table not found")
+	}
+
+	worlds.Worlds, err = p.readWorlds(regular, false)
+	if err != nil {
+		return worlds, fmt.Errorf("worlds: %w", err)
+	}
+
+	if tournament, ok := scrape.Table(content, tournamentCaption); ok {
+		tournamentWorlds, err := p.readWorlds(tournament, true)
+		if err != nil {
+			return worlds, fmt.Errorf("worlds: %w", err)
+		}
+		worlds.Worlds = append(worlds.Worlds, tournamentWorlds...)
+	}
+
+	return worlds, nil
+}
+
+func (p *Parser) readSummary(worlds *tibia.Worlds, content string) error {
+	summary, ok := scrape.Table(content, summaryCaption)
+	if !ok {
+		return fmt.Errorf("summary table not found")
+	}
+	summary = scrape.Text(summary)
+
+	online, _, ok := scrape.Between(
+		summary, playersOnlineIndexer, endPlayersOnlineIndexer,
+	)
+	if !ok {
+		return fmt.Errorf("players online not found")
+	}
+
+	var err error
+	worlds.PlayersOnline, err = scrape.Int(online)
+	if err != nil {
+		return fmt.Errorf("players online: %w", err)
+	}
+
+	record, rest, ok := scrape.Between(
+		summary, recordIndexer, endRecordIndexer,
+	)
+	if !ok {
+		return fmt.Errorf("online record not found")
+	}
+
+	worlds.RecordPlayers, err = scrape.Int(record)
+	if err != nil {
+		return fmt.Errorf("online record: %w", err)
+	}
+
+	recordTime, _, ok := strings.Cut(rest, endRecordTimeIndexer)
+	if !ok {
+		return fmt.Errorf("online record time not found")
+	}
+
+	worlds.RecordTime, err = scrape.DateTime(recordTime)
+	if err != nil {
+		return fmt.Errorf("online record time: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Parser) readWorlds(
+	table string,
+	tournament bool,
+) ([]tibia.WorldOverview, error) {
+	rows := scrape.Rows(table)
+	worlds := make([]tibia.WorldOverview, 0, len(rows))
+	for _, row := range rows {
+		cells := scrape.Cells(row)
+		if len(cells) < 6 {
+			continue
+		}
+
+		// Skip the header row.
+		if !strings.Contains(cells[0], "<a ") {
+			continue
+		}
+
+		world, err := p.readWorld(cells)
+		if err != nil {
+			return nil, err
+		}
+
+		world.Tournament = tournament
+		worlds = append(worlds, world)
+	}
+	return worlds, nil
+}
+
+func (p *Parser) readWorld(cells []string) (tibia.WorldOverview, error) {
+	world := tibia.WorldOverview{
+		Name:     scrape.Text(cells[0]),
+		Location: scrape.Text(cells[2]),
+	}
+
+	if online := scrape.Text(cells[1]); online != offlineWorld {
+		n, err := scrape.Int(online)
+		if err != nil {
+			return world, fmt.Errorf("%s players online: %w", world.Name, err)
+		}
+		world.PlayersOnline = n
+		world.Status = tibia.OnlineStatusOnline
+	}
+
+	pvpType, err := tibia.PvPTypeFromString(scrape.Text(cells[3]))
+	if err != nil {
+		return world, fmt.Errorf("%s pvp type: %w", world.Name, err)
+	}
+	world.PvPType = pvpType
+
+	switch {
+	case strings.Contains(cells[4], battleEyeInitialIndexer):
+		world.BattleEye = tibia.BattleEyeStatusInitiallyProtected
+	case strings.Contains(cells[4], battleEyeIndexer):
+		world.BattleEye = tibia.BattleEyeStatusProtected
+
+		// The date is only displayed in the tooltip of the icon.
+		since, _, ok := scrape.Between(
+			cells[4], battleEyeSinceIndexer, endBattleEyeSince,
+		)
+		if ok {
+			world.BattleEyeSince, err = scrape.Date(since)
+			if err != nil {
+				return world, fmt.Errorf(
+					"%s battle eye since: %w", world.Name, err,
+				)
+			}
+		}
+	default:
+		world.BattleEye = tibia.BattleEyeStatusUnprotected
+	}
+
+	for _, info := range strings.Split(scrape.Text(cells[5]), ",") {
+		switch strings.TrimSpace(info) {
+		case premiumInfo:
+			world.PremiumOnly = true
+		case transferLockedInfo:
+			world.TransferLocked = true
+		case transferBlockedInfo:
+			world.TransferBlocked = true
+		case experimentalInfo:
+			world.Experimental = true
+		}
+	}
+
+	return world, nil
+}
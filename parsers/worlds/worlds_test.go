@@ -0,0 +1,153 @@
+package worlds
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/phenpessoa/tibia-crawler/internal/static"
+	"github.com/phenpessoa/tibia-crawler/tibia"
+)
+
+func TestParser(t *testing.T) {
+	f, err := static.TestData.Open("testdata/worlds.html")
+	if err != nil {
+		t.Errorf("failed to open test data: %s\n%#v\n", err, err)
+		return
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("failed to read file: %s", err)
+	}
+
+	p := Parser{}
+
+	worlds, err := p.parse(string(data))
+	if err != nil {
+		t.Errorf("failed to parse data: %s\n%#v\n", err, err)
+		return
+	}
+
+	if worlds.PlayersOnline != 2642 {
+		t.Errorf(
+			"Wrong players online\nwant: %d\ngot: %d",
+			2642, worlds.PlayersOnline,
+		)
+	}
+
+	if worlds.RecordPlayers != 64028 {
+		t.Errorf(
+			"Wrong record players\nwant: %d\ngot: %d",
+			64028, worlds.RecordPlayers,
+		)
+	}
+
+	recordTime := time.Date(2007, time.November, 28, 15, 3, 57, 0, time.UTC)
+	if !worlds.RecordTime.Equal(recordTime) {
+		t.Errorf(
+			"Wrong record time\nwant: %s\ngot: %s",
+			recordTime, worlds.RecordTime,
+		)
+	}
+
+	if len(worlds.Worlds) != 6 {
+		t.Errorf("Wrong length\nwant: %d\ngot: %d", 6, len(worlds.Worlds))
+		return
+	}
+
+	for _, tc := range []struct {
+		idx  int
+		want tibia.WorldOverview
+	}{
+		{
+			idx: 0,
+			want: tibia.WorldOverview{
+				Name:          "Antica",
+				Status:        tibia.OnlineStatusOnline,
+				PlayersOnline: 1021,
+				Location:      "Europe",
+				PvPType:       tibia.PvPTypeOpenPvP,
+				BattleEye:     tibia.BattleEyeStatusInitiallyProtected,
+			},
+		},
+		{
+			idx: 1,
+			want: tibia.WorldOverview{
+				Name:          "Bona",
+				Status:        tibia.OnlineStatusOnline,
+				PlayersOnline: 317,
+				Location:      "Europe",
+				PvPType:       tibia.PvPTypeOptionalPvP,
+				BattleEye:     tibia.BattleEyeStatusProtected,
+				BattleEyeSince: time.Date(
+					2017, time.August, 29, 0, 0, 0, 0, time.UTC,
+				),
+				TransferLocked: true,
+			},
+		},
+		{
+			idx: 2,
+			want: tibia.WorldOverview{
+				Name:        "Celesta",
+				Status:      tibia.OnlineStatusOffline,
+				Location:    "Europe",
+				PvPType:     tibia.PvPTypeRetroOpenPvP,
+				BattleEye:   tibia.BattleEyeStatusUnprotected,
+				PremiumOnly: true,
+			},
+		},
+		{
+			idx: 3,
+			want: tibia.WorldOverview{
+				Name:            "Dracobra",
+				Status:          tibia.OnlineStatusOnline,
+				PlayersOnline:   88,
+				Location:        "North America",
+				PvPType:         tibia.PvPTypeHardcorePvP,
+				BattleEye:       tibia.BattleEyeStatusInitiallyProtected,
+				PremiumOnly:     true,
+				TransferBlocked: true,
+			},
+		},
+		{
+			idx: 4,
+			want: tibia.WorldOverview{
+				Name:          "Zunera",
+				Status:        tibia.OnlineStatusOnline,
+				PlayersOnline: 1204,
+				Location:      "South America",
+				PvPType:       tibia.PvPTypeRetroHardcorePvP,
+				BattleEye:     tibia.BattleEyeStatusProtected,
+				BattleEyeSince: time.Date(
+					2021, time.January, 12, 0, 0, 0, 0, time.UTC,
+				),
+				Experimental: true,
+			},
+		},
+		{
+			idx: 5,
+			want: tibia.WorldOverview{
+				Name:            "Endebra",
+				Status:          tibia.OnlineStatusOnline,
+				PlayersOnline:   12,
+				Location:        "Europe",
+				PvPType:         tibia.PvPTypeOpenPvP,
+				BattleEye:       tibia.BattleEyeStatusInitiallyProtected,
+				TransferBlocked: true,
+				Tournament:      true,
+			},
+		},
+	} {
+		t.Run(tc.want.Name, func(t *testing.T) {
+			got := worlds.Worlds[tc.idx]
+			if got != tc.want {
+				t.Errorf(
+					"Wrong world\nidx: %d\nwant: %+v\ngot: %+v",
+					tc.idx, tc.want, got,
+				)
+			}
+		})
+	}
+}
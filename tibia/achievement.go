@@ -0,0 +1,48 @@
+package tibia
+
+// Achievement represents an achievement displayed on the tibia.com
+// Achievements Library page.
+type Achievement struct {
+	// Name is the name of the achievement.
+	Name string `json:"name"`
+
+	// Grade is the grade of the achievement, from 1 to 3.
+	Grade int `json:"grade"`
+
+	// Points is the amount of achievement points the achievement is worth.
+	Points int `json:"points"`
+
+	// Description is the description of the achievement.
+	Description string `json:"description"`
+
+	// Secret reports whether the achievement is a secret achievement.
+	Secret bool `json:"secret"`
+
+	// Premium reports whether only premium characters can obtain the
+	// achievement.
+	Premium bool `json:"premium"`
+}
+
+// MissingAchievements returns the achievements of library that are not part
+// of displayed, compared by name.
+//
+// Characters only display the achievements their owners chose to, so the
+// result is an upper bound of the achievements the character is missing.
+func MissingAchievements(
+	library []Achievement,
+	displayed []DisplayedAchievement,
+) []Achievement {
+	names := make(map[string]struct{}, len(displayed))
+	for _, achievement := range displayed {
+		names[achievement.Name] = struct{}{}
+	}
+
+	var missing []Achievement
+	for _, achievement := range library {
+		if _, ok := names[achievement.Name]; !ok {
+			missing = append(missing, achievement)
+		}
+	}
+
+	return missing
+}
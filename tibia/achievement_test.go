@@ -0,0 +1,33 @@
+package tibia
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMissingAchievements(t *testing.T) {
+	library := []Achievement{
+		{Name: "Allow Cookies?", Grade: 1, Points: 1, Secret: true},
+		{Name: "Bone Brother", Grade: 1, Points: 1, Premium: true},
+		{Name: "Wayfarer", Grade: 1, Points: 2},
+	}
+
+	displayed := []DisplayedAchievement{
+		{Name: "Allow Cookies?", Grade: 1, Secret: true},
+		{Name: "Ultimate Warlord", Grade: 3},
+	}
+
+	want := []Achievement{library[1], library[2]}
+
+	got := MissingAchievements(library, displayed)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Wrong missing achievements\nwant: %+v\ngot: %+v", want, got)
+	}
+
+	if got := MissingAchievements(library, nil); len(got) != len(library) {
+		t.Errorf(
+			"Wrong missing achievements count\nwant: %d\ngot: %d",
+			len(library), len(got),
+		)
+	}
+}
@@ -0,0 +1,242 @@
+package tibia
+
+import "time"
+
+// Auctions represents a page of the auctions of the tibia.com Char Bazaar.
+type Auctions struct {
+	// Page is the current page.
+	Page int `json:"page"`
+
+	// TotalPages is the amount of pages of auctions.
+	TotalPages int `json:"total_pages"`
+
+	// TotalResults is the amount of auctions, across all pages.
+	TotalResults int `json:"total_results"`
+
+	// Auctions is a list of the auctions of the current page.
+	Auctions []AuctionOverview `json:"auctions"`
+}
+
+// AuctionOverview represents the information about an auction displayed on
+// the tibia.com Char Bazaar lists.
+type AuctionOverview struct {
+	// ID is the identifier tibia.com uses for the auction.
+	ID int `json:"id"`
+
+	// Character is the name of the character being sold.
+	Character string `json:"character"`
+
+	// Level is the level of the character.
+	Level int `json:"level"`
+
+	// Vocation is the vocation of the character.
+	Vocation Vocation `json:"vocation"`
+
+	// World is the world the character is on.
+	World string `json:"world"`
+
+	// Start is when the auction started.
+	Start time.Time `json:"start"`
+
+	// End is when the auction ends, or ended.
+	End time.Time `json:"end"`
+
+	// Bid is the amount of Tibia Coins of the bid displayed by the auction.
+	// See BidStatus.
+	Bid int `json:"bid"`
+
+	// BidStatus is whether Bid is the minimum bid or the current bid.
+	BidStatus AuctionBidStatus `json:"bid_status"`
+}
+
+// PastAuctions represents a page of the auction history of the tibia.com Char
+// Bazaar.
+type PastAuctions struct {
+	// Page is the current page.
+	Page int `json:"page"`
+
+	// TotalPages is the amount of pages of auctions.
+	TotalPages int `json:"total_pages"`
+
+	// TotalResults is the amount of auctions, across all pages.
+	TotalResults int `json:"total_results"`
+
+	// Auctions is a list of the auctions of the current page.
+	Auctions []PastAuction `json:"auctions"`
+}
+
+// PastAuction represents the information about a finished auction displayed
+// on the tibia.com Char Bazaar auction history.
+type PastAuction struct {
+	AuctionOverview
+
+	// Outcome is how the auction ended.
+	//
+	// If Outcome is AuctionOutcomeSold, Bid is the final bid of the auction.
+	Outcome AuctionOutcome `json:"outcome"`
+}
+
+// Auction represents the information about an auction displayed on its
+// tibia.com Char Bazaar page.
+type Auction struct {
+	AuctionOverview
+
+	// HitPoints is the amount of hit points of the character.
+	HitPoints int `json:"hit_points"`
+
+	// Mana is the amount of mana of the character.
+	Mana int `json:"mana"`
+
+	// Capacity is the capacity of the character.
+	Capacity int `json:"capacity"`
+
+	// Speed is the speed of the character.
+	Speed int `json:"speed"`
+
+	// Created is when the character was created.
+	Created time.Time `json:"created"`
+
+	// Experience is the amount of experience points of the character.
+	Experience int `json:"experience"`
+
+	// Gold is the amount of gold of the character, in gold coins.
+	Gold int `json:"gold"`
+
+	// AchievementPoints is the amount of achievement points of the character.
+	AchievementPoints int `json:"achievement_points"`
+
+	// AvailableCharmPoints is the amount of charm points the character can
+	// still spend.
+	AvailableCharmPoints int `json:"available_charm_points"`
+
+	// SpentCharmPoints is the amount of charm points the character spent on
+	// charms.
+	SpentCharmPoints int `json:"spent_charm_points"`
+
+	// Skills is a list of the skills of the character.
+	Skills []AuctionSkill `json:"skills"`
+
+	// Items is a list of the items of the character.
+	Items []AuctionItem `json:"items,omitempty"`
+
+	// StoreItems is a list of the items of the character bought on the
+	// store.
+	StoreItems []AuctionItem `json:"store_items,omitempty"`
+
+	// Outfits is a list of the outfits of the character.
+	Outfits []AuctionOutfit `json:"outfits,omitempty"`
+
+	// StoreOutfits is a list of the outfits of the character bought on the
+	// store.
+	StoreOutfits []AuctionOutfit `json:"store_outfits,omitempty"`
+
+	// Mounts is a list of the names of the mounts of the character.
+	Mounts []string `json:"mounts,omitempty"`
+
+	// StoreMounts is a list of the names of the mounts of the character
+	// bought on the store.
+	StoreMounts []string `json:"store_mounts,omitempty"`
+
+	// Charms is a list of the charms the character unlocked.
+	Charms []AuctionCharm `json:"charms,omitempty"`
+
+	// Bestiary is the progress of the character on the bestiary.
+	Bestiary []AuctionCreatureProgress `json:"bestiary,omitempty"`
+
+	// Bosstiary is the progress of the character on the bosstiary.
+	Bosstiary []AuctionCreatureProgress `json:"bosstiary,omitempty"`
+
+	// Achievements is a list of the names of the achievements of the
+	// character.
+	Achievements []string `json:"achievements,omitempty"`
+
+	// Blessings is a list of the blessings available and how many charges of
+	// each the character has.
+	Blessings []AuctionBlessing `json:"blessings,omitempty"`
+
+	// Imbuements is a list of the names of the imbuements the character
+	// unlocked.
+	Imbuements []string `json:"imbuements,omitempty"`
+
+	// QuestLines is a list of the names of the quest lines the character
+	// completed.
+	QuestLines []string `json:"quest_lines,omitempty"`
+
+	// Hirelings is the information about the hirelings of the character.
+	Hirelings AuctionHirelings `json:"hirelings"`
+}
+
+// AuctionSkill represents a skill of a character being auctioned.
+type AuctionSkill struct {
+	// Skill is the skill.
+	Skill Skill `json:"skill"`
+
+	// Level is the level of the skill.
+	Level int `json:"level"`
+
+	// Percent is the progress to the next level of the skill, from 0 to 100.
+	Percent float64 `json:"percent"`
+}
+
+// AuctionItem represents an item of a character being auctioned.
+type AuctionItem struct {
+	// Name is the name of the item.
+	Name string `json:"name"`
+
+	// Count is the amount of the item the character has.
+	Count int `json:"count"`
+}
+
+// AuctionOutfit represents an outfit of a character being auctioned.
+type AuctionOutfit struct {
+	// Name is the name of the outfit.
+	Name string `json:"name"`
+
+	// Addons is a bit mask of the addons of the outfit the character has,
+	// 1 being the first addon and 2 being the second one.
+	Addons int `json:"addons"`
+}
+
+// AuctionCharm represents a charm unlocked by a character being auctioned.
+type AuctionCharm struct {
+	// Name is the name of the charm.
+	Name string `json:"name"`
+
+	// Cost is the amount of charm points the charm costs.
+	Cost int `json:"cost"`
+}
+
+// AuctionCreatureProgress represents the progress of a character being
+// auctioned on the bestiary or bosstiary entry of a creature.
+type AuctionCreatureProgress struct {
+	// Name is the name of the creature.
+	Name string `json:"name"`
+
+	// Kills is the amount of times the character killed the creature.
+	Kills int `json:"kills"`
+
+	// Step is the progress step the character reached.
+	Step int `json:"step"`
+}
+
+// AuctionBlessing represents a blessing of a character being auctioned.
+type AuctionBlessing struct {
+	// Name is the name of the blessing.
+	Name string `json:"name"`
+
+	// Amount is the amount of charges of the blessing the character has.
+	Amount int `json:"amount"`
+}
+
+// AuctionHirelings represents the hirelings of a character being auctioned.
+type AuctionHirelings struct {
+	// Count is the amount of hirelings of the character.
+	Count int `json:"count"`
+
+	// Jobs is a list of the jobs the hirelings of the character unlocked.
+	Jobs []string `json:"jobs,omitempty"`
+
+	// Outfits is a list of the outfits the hirelings of the character
+	// unlocked.
+	Outfits []string `json:"outfits,omitempty"`
+}
@@ -0,0 +1,148 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AuctionBidStatusFromString converts a string representation of an auction bid
+// status to its corresponding AuctionBidStatus.
+//
+// This conversion allows you to work with auction bid statuses in a more
+// convenient and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known auction bid status values. If a match is found, the
+// corresponding AuctionBidStatus is returned along with a nil error.
+//
+// If the provided string does not match any known auction bid status values, an
+// ErrUnknownAuctionBidStatus is returned.
+//
+// Strings representing the integer value of an AuctionBidStatus (i.e. "1" for
+// Current Bid) will also be parsed into their corresponding AuctionBidStatus.
+func AuctionBidStatusFromString(bs string) (AuctionBidStatus, error) {
+	switch strings.ToLower(bs) {
+	case "minimum bid", "minimum", "0":
+		return AuctionBidStatusMinimum, nil
+	case "current bid", "current", "1":
+		return AuctionBidStatusCurrent, nil
+	case "winning bid", "winning", "2":
+		return AuctionBidStatusWinning, nil
+	default:
+		return AuctionBidStatus{}, ErrUnknownAuctionBidStatus
+	}
+}
+
+// AuctionBidStatusFromInt converts an integer representation of an auction bid
+// status to its corresponding AuctionBidStatus.
+//
+// This conversion allows you to work with auction bid statuses in a more
+// convenient and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// auction bid status values. If a match is found, the corresponding
+// AuctionBidStatus is returned along with a nil error.
+//
+// If the provided integer does not match any known auction bid status values,
+// an ErrUnknownAuctionBidStatus is returned.
+func AuctionBidStatusFromInt(bs int) (AuctionBidStatus, error) {
+	switch bs {
+	case 0:
+		return AuctionBidStatusMinimum, nil
+	case 1:
+		return AuctionBidStatusCurrent, nil
+	case 2:
+		return AuctionBidStatusWinning, nil
+	default:
+		return AuctionBidStatus{}, ErrUnknownAuctionBidStatus
+	}
+}
+
+// AuctionBidStatus represents what the bid displayed by an auction of the Char
+// Bazaar is.
+type AuctionBidStatus struct {
+	bs int
+}
+
+var (
+	// AuctionBidStatusMinimum represents an auction without bids, whose bid is
+	// the minimum bid set by the seller.
+	AuctionBidStatusMinimum = AuctionBidStatus{0}
+
+	// AuctionBidStatusCurrent represents an auction with bids, whose bid is the
+	// highest bid so far.
+	AuctionBidStatusCurrent = AuctionBidStatus{1}
+
+	// AuctionBidStatusWinning represents a finished auction that was sold,
+	// whose bid is the bid that won the auction.
+	AuctionBidStatusWinning = AuctionBidStatus{2}
+)
+
+// ID returns the integer representation of the AuctionBidStatus.
+//
+// It can be used to access the numerical representation of the AuctionBidStatus
+// when needed.
+func (bs AuctionBidStatus) ID() int {
+	return bs.bs
+}
+
+// String returns the string representation of the AuctionBidStatus.
+func (bs AuctionBidStatus) String() string {
+	switch bs {
+	case AuctionBidStatusMinimum:
+		return "Minimum Bid"
+	case AuctionBidStatusCurrent:
+		return "Current Bid"
+	case AuctionBidStatusWinning:
+		return "Winning Bid"
+	default:
+		panic("unknown bs")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (bs *AuctionBidStatus) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal auction bid status: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return bs.unmarshalFromString(v)
+	case float64:
+		return bs.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into auction bid status", v)
+	}
+}
+
+func (bs *AuctionBidStatus) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_bs, err := AuctionBidStatusFromString(data)
+	if err != nil {
+		return fmt.Errorf("auction bid status unmarshal: %w", err)
+	}
+
+	*bs = _bs
+	return nil
+}
+
+func (bs *AuctionBidStatus) unmarshalFromInt(data int) error {
+	_bs, err := AuctionBidStatusFromInt(data)
+	if err != nil {
+		return fmt.Errorf("auction bid status unmarshal: %w", err)
+	}
+
+	*bs = _bs
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (bs AuctionBidStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + bs.String() + `"`), nil
+}
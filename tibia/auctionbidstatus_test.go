@@ -0,0 +1,130 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestAuctionBidStatusJsonMarshal(t *testing.T) {
+	type Test struct {
+		BS AuctionBidStatus `json:"auction_bid_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "minimum bid",
+			input: Test{AuctionBidStatusMinimum},
+			want:  []byte(`{"auction_bid_status":"Minimum Bid"}`),
+		},
+		{
+			name:  "current bid",
+			input: Test{AuctionBidStatusCurrent},
+			want:  []byte(`{"auction_bid_status":"Current Bid"}`),
+		},
+		{
+			name:  "winning bid",
+			input: Test{AuctionBidStatusWinning},
+			want:  []byte(`{"auction_bid_status":"Winning Bid"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestAuctionBidStatusJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		BS AuctionBidStatus `json:"auction_bid_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "minimum bid",
+			want:  Test{AuctionBidStatusMinimum},
+			input: []byte(`{"auction_bid_status":"Minimum Bid"}`),
+		},
+		{
+			name:  "current bid",
+			want:  Test{AuctionBidStatusCurrent},
+			input: []byte(`{"auction_bid_status":"Current Bid"}`),
+		},
+		{
+			name:  "winning bid",
+			want:  Test{AuctionBidStatusWinning},
+			input: []byte(`{"auction_bid_status":"Winning Bid"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "minimum bid str int",
+			want:  Test{AuctionBidStatusMinimum},
+			input: []byte(`{"auction_bid_status":"0"}`),
+		},
+		{
+			name:  "current bid str int",
+			want:  Test{AuctionBidStatusCurrent},
+			input: []byte(`{"auction_bid_status":"1"}`),
+		},
+		{
+			name:  "winning bid str int",
+			want:  Test{AuctionBidStatusWinning},
+			input: []byte(`{"auction_bid_status":"2"}`),
+		},
+		{
+			name:  "minimum bid int",
+			want:  Test{AuctionBidStatusMinimum},
+			input: []byte(`{"auction_bid_status":0}`),
+		},
+		{
+			name:  "current bid int",
+			want:  Test{AuctionBidStatusCurrent},
+			input: []byte(`{"auction_bid_status":1}`),
+		},
+		{
+			name:  "winning bid int",
+			want:  Test{AuctionBidStatusWinning},
+			input: []byte(`{"auction_bid_status":2}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var bs Test
+			if err := json.Unmarshal(tc.input, &bs); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if bs != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, bs,
+				)
+				return
+			}
+		})
+	}
+}
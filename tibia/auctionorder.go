@@ -0,0 +1,197 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AuctionOrderFromString converts a string representation of an auction order
+// to its corresponding AuctionOrder.
+//
+// This conversion allows you to work with auction orders in a more convenient
+// and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known auction order values. If a match is found, the corresponding
+// AuctionOrder is returned along with a nil error.
+//
+// If the provided string does not match any known auction order values, an
+// ErrUnknownAuctionOrder is returned.
+//
+// Strings representing the integer value of an AuctionOrder (i.e. "2" for
+// Level) will also be parsed into their corresponding AuctionOrder.
+func AuctionOrderFromString(ao string) (AuctionOrder, error) {
+	switch strings.ToLower(ao) {
+	case "end time", "end", "0":
+		return AuctionOrderEndTime, nil
+	case "bid", "1":
+		return AuctionOrderBid, nil
+	case "level", "2":
+		return AuctionOrderLevel, nil
+	case "start time", "start", "3":
+		return AuctionOrderStartTime, nil
+	default:
+		return AuctionOrder{}, ErrUnknownAuctionOrder
+	}
+}
+
+// AuctionOrderFromInt converts an integer representation of an auction order to
+// its corresponding AuctionOrder.
+//
+// This conversion allows you to work with auction orders in a more convenient
+// and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// auction order values. If a match is found, the corresponding AuctionOrder is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known auction order values, an
+// ErrUnknownAuctionOrder is returned.
+func AuctionOrderFromInt(ao int) (AuctionOrder, error) {
+	switch ao {
+	case 0:
+		return AuctionOrderEndTime, nil
+	case 1:
+		return AuctionOrderBid, nil
+	case 2:
+		return AuctionOrderLevel, nil
+	case 3:
+		return AuctionOrderStartTime, nil
+	default:
+		return AuctionOrder{}, ErrUnknownAuctionOrder
+	}
+}
+
+// AuctionOrder represents the column the auctions of the Char Bazaar are
+// ordered by.
+type AuctionOrder struct {
+	ao int
+}
+
+var (
+	// AuctionOrderEndTime orders the auctions by the time they end. It is the
+	// default order of tibia.com.
+	AuctionOrderEndTime = AuctionOrder{0}
+
+	// AuctionOrderBid orders the auctions by their current or minimum bid.
+	AuctionOrderBid = AuctionOrder{1}
+
+	// AuctionOrderLevel orders the auctions by the level of their characters.
+	AuctionOrderLevel = AuctionOrder{2}
+
+	// AuctionOrderStartTime orders the auctions by the time they started.
+	AuctionOrderStartTime = AuctionOrder{3}
+)
+
+// ID returns the integer representation of the AuctionOrder.
+//
+// It can be used to access the numerical representation of the AuctionOrder
+// when needed.
+func (ao AuctionOrder) ID() int {
+	return ao.ao
+}
+
+// QueryVal returns the query parameter value representation of the
+// AuctionOrder.
+//
+// The QueryVal method returns the string representation of the AuctionOrder,
+// suitable for use as a query parameter value when making requests to tibia.com
+// endpoints that support filtering by an auction order.
+//
+// Example usage:
+//
+//	ao := tibia.AuctionOrderLevel
+//	vals := url.Values{}
+//	vals.Set(ao.QueryKey(), ao.QueryVal())
+func (ao AuctionOrder) QueryVal() string {
+	switch ao {
+	case AuctionOrderEndTime:
+		return "101"
+	case AuctionOrderBid:
+		return "100"
+	case AuctionOrderLevel:
+		return "102"
+	case AuctionOrderStartTime:
+		return "103"
+	default:
+		panic("unknown ao")
+	}
+}
+
+// QueryKey returns the query parameter key for filtering by AuctionOrder.
+//
+// The QueryKey method returns the string representation of the query parameter
+// key to be used when filtering by an auction order in tibia.com requests. This
+// key can be appended to the query string to specify the desired auction order.
+//
+// Example usage:
+//
+//	ao := tibia.AuctionOrderLevel
+//	vals := url.Values{}
+//	vals.Set(ao.QueryKey(), ao.QueryVal())
+func (ao AuctionOrder) QueryKey() string {
+	return "order_column"
+}
+
+// String returns the string representation of the AuctionOrder.
+func (ao AuctionOrder) String() string {
+	switch ao {
+	case AuctionOrderEndTime:
+		return "End Time"
+	case AuctionOrderBid:
+		return "Bid"
+	case AuctionOrderLevel:
+		return "Level"
+	case AuctionOrderStartTime:
+		return "Start Time"
+	default:
+		panic("unknown ao")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (ao *AuctionOrder) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal auction order: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return ao.unmarshalFromString(v)
+	case float64:
+		return ao.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into auction order", v)
+	}
+}
+
+func (ao *AuctionOrder) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_ao, err := AuctionOrderFromString(data)
+	if err != nil {
+		return fmt.Errorf("auction order unmarshal: %w", err)
+	}
+
+	*ao = _ao
+	return nil
+}
+
+func (ao *AuctionOrder) unmarshalFromInt(data int) error {
+	_ao, err := AuctionOrderFromInt(data)
+	if err != nil {
+		return fmt.Errorf("auction order unmarshal: %w", err)
+	}
+
+	*ao = _ao
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (ao AuctionOrder) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + ao.String() + `"`), nil
+}
@@ -0,0 +1,150 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestAuctionOrderJsonMarshal(t *testing.T) {
+	type Test struct {
+		AO AuctionOrder `json:"auction_order"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "end time",
+			input: Test{AuctionOrderEndTime},
+			want:  []byte(`{"auction_order":"End Time"}`),
+		},
+		{
+			name:  "bid",
+			input: Test{AuctionOrderBid},
+			want:  []byte(`{"auction_order":"Bid"}`),
+		},
+		{
+			name:  "level",
+			input: Test{AuctionOrderLevel},
+			want:  []byte(`{"auction_order":"Level"}`),
+		},
+		{
+			name:  "start time",
+			input: Test{AuctionOrderStartTime},
+			want:  []byte(`{"auction_order":"Start Time"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestAuctionOrderJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		AO AuctionOrder `json:"auction_order"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "end time",
+			want:  Test{AuctionOrderEndTime},
+			input: []byte(`{"auction_order":"End Time"}`),
+		},
+		{
+			name:  "bid",
+			want:  Test{AuctionOrderBid},
+			input: []byte(`{"auction_order":"Bid"}`),
+		},
+		{
+			name:  "level",
+			want:  Test{AuctionOrderLevel},
+			input: []byte(`{"auction_order":"Level"}`),
+		},
+		{
+			name:  "start time",
+			want:  Test{AuctionOrderStartTime},
+			input: []byte(`{"auction_order":"Start Time"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "end time str int",
+			want:  Test{AuctionOrderEndTime},
+			input: []byte(`{"auction_order":"0"}`),
+		},
+		{
+			name:  "bid str int",
+			want:  Test{AuctionOrderBid},
+			input: []byte(`{"auction_order":"1"}`),
+		},
+		{
+			name:  "level str int",
+			want:  Test{AuctionOrderLevel},
+			input: []byte(`{"auction_order":"2"}`),
+		},
+		{
+			name:  "start time str int",
+			want:  Test{AuctionOrderStartTime},
+			input: []byte(`{"auction_order":"3"}`),
+		},
+		{
+			name:  "end time int",
+			want:  Test{AuctionOrderEndTime},
+			input: []byte(`{"auction_order":0}`),
+		},
+		{
+			name:  "bid int",
+			want:  Test{AuctionOrderBid},
+			input: []byte(`{"auction_order":1}`),
+		},
+		{
+			name:  "level int",
+			want:  Test{AuctionOrderLevel},
+			input: []byte(`{"auction_order":2}`),
+		},
+		{
+			name:  "start time int",
+			want:  Test{AuctionOrderStartTime},
+			input: []byte(`{"auction_order":3}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var ao Test
+			if err := json.Unmarshal(tc.input, &ao); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if ao != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, ao,
+				)
+				return
+			}
+		})
+	}
+}
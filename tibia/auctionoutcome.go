@@ -0,0 +1,144 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AuctionOutcomeFromString converts a string representation of an auction
+// outcome to its corresponding AuctionOutcome.
+//
+// This conversion allows you to work with auction outcomes in a more convenient
+// and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known auction outcome values. If a match is found, the corresponding
+// AuctionOutcome is returned along with a nil error.
+//
+// If the provided string does not match any known auction outcome values, an
+// ErrUnknownAuctionOutcome is returned.
+//
+// Strings representing the integer value of an AuctionOutcome (i.e. "1" for Not
+// Sold) will also be parsed into their corresponding AuctionOutcome.
+func AuctionOutcomeFromString(ao string) (AuctionOutcome, error) {
+	switch strings.ToLower(ao) {
+	case "sold", "0":
+		return AuctionOutcomeSold, nil
+	case "not sold", "notsold", "1":
+		return AuctionOutcomeNotSold, nil
+	case "cancelled", "canceled", "2":
+		return AuctionOutcomeCancelled, nil
+	default:
+		return AuctionOutcome{}, ErrUnknownAuctionOutcome
+	}
+}
+
+// AuctionOutcomeFromInt converts an integer representation of an auction
+// outcome to its corresponding AuctionOutcome.
+//
+// This conversion allows you to work with auction outcomes in a more convenient
+// and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// auction outcome values. If a match is found, the corresponding AuctionOutcome
+// is returned along with a nil error.
+//
+// If the provided integer does not match any known auction outcome values, an
+// ErrUnknownAuctionOutcome is returned.
+func AuctionOutcomeFromInt(ao int) (AuctionOutcome, error) {
+	switch ao {
+	case 0:
+		return AuctionOutcomeSold, nil
+	case 1:
+		return AuctionOutcomeNotSold, nil
+	case 2:
+		return AuctionOutcomeCancelled, nil
+	default:
+		return AuctionOutcome{}, ErrUnknownAuctionOutcome
+	}
+}
+
+// AuctionOutcome represents how a finished auction of the Char Bazaar ended.
+type AuctionOutcome struct {
+	ao int
+}
+
+var (
+	// AuctionOutcomeSold represents an auction that was won by a bidder.
+	AuctionOutcomeSold = AuctionOutcome{0}
+
+	// AuctionOutcomeNotSold represents an auction that ended without bids.
+	AuctionOutcomeNotSold = AuctionOutcome{1}
+
+	// AuctionOutcomeCancelled represents an auction that was cancelled.
+	AuctionOutcomeCancelled = AuctionOutcome{2}
+)
+
+// ID returns the integer representation of the AuctionOutcome.
+//
+// It can be used to access the numerical representation of the AuctionOutcome
+// when needed.
+func (ao AuctionOutcome) ID() int {
+	return ao.ao
+}
+
+// String returns the string representation of the AuctionOutcome.
+func (ao AuctionOutcome) String() string {
+	switch ao {
+	case AuctionOutcomeSold:
+		return "Sold"
+	case AuctionOutcomeNotSold:
+		return "Not Sold"
+	case AuctionOutcomeCancelled:
+		return "Cancelled"
+	default:
+		panic("unknown ao")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (ao *AuctionOutcome) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal auction outcome: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return ao.unmarshalFromString(v)
+	case float64:
+		return ao.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into auction outcome", v)
+	}
+}
+
+func (ao *AuctionOutcome) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_ao, err := AuctionOutcomeFromString(data)
+	if err != nil {
+		return fmt.Errorf("auction outcome unmarshal: %w", err)
+	}
+
+	*ao = _ao
+	return nil
+}
+
+func (ao *AuctionOutcome) unmarshalFromInt(data int) error {
+	_ao, err := AuctionOutcomeFromInt(data)
+	if err != nil {
+		return fmt.Errorf("auction outcome unmarshal: %w", err)
+	}
+
+	*ao = _ao
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (ao AuctionOutcome) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + ao.String() + `"`), nil
+}
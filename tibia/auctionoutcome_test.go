@@ -0,0 +1,130 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestAuctionOutcomeJsonMarshal(t *testing.T) {
+	type Test struct {
+		AO AuctionOutcome `json:"auction_outcome"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "sold",
+			input: Test{AuctionOutcomeSold},
+			want:  []byte(`{"auction_outcome":"Sold"}`),
+		},
+		{
+			name:  "not sold",
+			input: Test{AuctionOutcomeNotSold},
+			want:  []byte(`{"auction_outcome":"Not Sold"}`),
+		},
+		{
+			name:  "cancelled",
+			input: Test{AuctionOutcomeCancelled},
+			want:  []byte(`{"auction_outcome":"Cancelled"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestAuctionOutcomeJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		AO AuctionOutcome `json:"auction_outcome"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "sold",
+			want:  Test{AuctionOutcomeSold},
+			input: []byte(`{"auction_outcome":"Sold"}`),
+		},
+		{
+			name:  "not sold",
+			want:  Test{AuctionOutcomeNotSold},
+			input: []byte(`{"auction_outcome":"Not Sold"}`),
+		},
+		{
+			name:  "cancelled",
+			want:  Test{AuctionOutcomeCancelled},
+			input: []byte(`{"auction_outcome":"Cancelled"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "sold str int",
+			want:  Test{AuctionOutcomeSold},
+			input: []byte(`{"auction_outcome":"0"}`),
+		},
+		{
+			name:  "not sold str int",
+			want:  Test{AuctionOutcomeNotSold},
+			input: []byte(`{"auction_outcome":"1"}`),
+		},
+		{
+			name:  "cancelled str int",
+			want:  Test{AuctionOutcomeCancelled},
+			input: []byte(`{"auction_outcome":"2"}`),
+		},
+		{
+			name:  "sold int",
+			want:  Test{AuctionOutcomeSold},
+			input: []byte(`{"auction_outcome":0}`),
+		},
+		{
+			name:  "not sold int",
+			want:  Test{AuctionOutcomeNotSold},
+			input: []byte(`{"auction_outcome":1}`),
+		},
+		{
+			name:  "cancelled int",
+			want:  Test{AuctionOutcomeCancelled},
+			input: []byte(`{"auction_outcome":2}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var ao Test
+			if err := json.Unmarshal(tc.input, &ao); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if ao != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, ao,
+				)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package tibia
+
+import "time"
+
+// Character represents information about a tibia character.
+//
+// The Character struct contains the details displayed on the tibia.com
+// Characters page, such as the character's level, vocation and world.
+type Character struct {
+	// Name is the name of the character.
+	Name string `json:"name"`
+
+	// FormerNames is a list of names previously used by the character.
+	FormerNames []string `json:"former_names,omitempty"`
+
+	// Deletion is the deletion status of the character.
+	Deletion DeletionStatus `json:"deletion"`
+
+	// DeletionTime is when the character will be deleted.
+	//
+	// DeletionTime is only set if Deletion is DeletionStatusScheduled.
+	DeletionTime time.Time `json:"deletion_time"`
+
+	// Traded reports whether the character was traded in the Char Bazaar
+	// recently.
+	Traded bool `json:"traded"`
+
+	// Title is the title currently selected by the character.
+	//
+	// Title is empty if the character has no title selected.
+	Title string `json:"title,omitempty"`
+
+	// UnlockedTitles is the amount of titles unlocked by the character.
+	UnlockedTitles int `json:"unlocked_titles"`
+
+	// Sex is the sex of the character, either "male" or "female".
+	Sex string `json:"sex"`
+
+	// Vocation is the vocation of the character.
+	Vocation Vocation `json:"vocation"`
+
+	// Level is the level of the character.
+	Level int `json:"level"`
+
+	// AchievementPoints is the amount of achievement points of the character.
+	AchievementPoints int `json:"achievement_points"`
+
+	// World is the world the character is currently on.
+	World string `json:"world"`
+
+	// FormerWorld is the world the character was on before its last
+	// transfer.
+	FormerWorld string `json:"former_world,omitempty"`
+
+	// Residence is the town the character lives in.
+	Residence string `json:"residence"`
+
+	// Houses is a list of the houses owned by the character.
+	Houses []CharacterHouse `json:"houses,omitempty"`
+
+	// Guild is the guild membership of the character.
+	//
+	// Guild is nil if the character is not a member of a guild.
+	Guild *GuildMembership `json:"guild,omitempty"`
+
+	// LastLogin is the last time the character logged in.
+	//
+	// LastLogin is the zero time if the character never logged in.
+	LastLogin time.Time `json:"last_login"`
+
+	// Comment is the comment written by the owner of the character.
+	Comment string `json:"comment,omitempty"`
+
+	// AccountStatus is the status of the account of the character, i.e.
+	// "Premium Account".
+	AccountStatus string `json:"account_status"`
+
+	// LoyaltyTitle is the loyalty title of the account of the character.
+	//
+	// LoyaltyTitle is empty if the account has no loyalty title or if its
+	// information is hidden.
+	LoyaltyTitle string `json:"loyalty_title,omitempty"`
+
+	// Deaths is a list of the recent deaths of the character, from the most
+	// recent to the oldest.
+	Deaths []Death `json:"deaths,omitempty"`
+
+	// Account is the information about the account of the character.
+	//
+	// Account is nil if tibia.com does not display account information for
+	// the character.
+	Account *AccountInformation `json:"account,omitempty"`
+
+	// AccountBadges is a list of the badges displayed by the account of the
+	// character.
+	AccountBadges []AccountBadge `json:"account_badges,omitempty"`
+
+	// Achievements is a list of the achievements the character chose to
+	// display on tibia.com.
+	Achievements []DisplayedAchievement `json:"achievements,omitempty"`
+
+	// AccountVisibility reports whether the owner of the account chose to
+	// hide the other characters of the account.
+	AccountVisibility Visibility `json:"account_visibility"`
+
+	// OtherCharacters is a list of all the characters of the account,
+	// including this one.
+	//
+	// OtherCharacters is empty if AccountVisibility is VisibilityHidden.
+	OtherCharacters []OtherCharacter `json:"other_characters,omitempty"`
+}
+
+// CharacterHouse represents a house owned by a character.
+type CharacterHouse struct {
+	// ID is the ID of the house.
+	ID int `json:"id"`
+
+	// Name is the name of the house.
+	Name string `json:"name"`
+
+	// Town is the town the house is located in.
+	Town string `json:"town"`
+
+	// PaidUntil is the date until which the rent of the house is paid.
+	PaidUntil time.Time `json:"paid_until"`
+}
+
+// GuildMembership represents the membership of a character in a guild.
+type GuildMembership struct {
+	// Name is the name of the guild.
+	Name string `json:"name"`
+
+	// Rank is the rank of the character in the guild.
+	Rank string `json:"rank"`
+}
+
+// Death represents a death of a character.
+//
+// tibia.com displays deaths as a sentence, such as
+// "Killed at Level 300 by Bubble and a dragon lord. Assisted by Cachero.".
+// Death splits that sentence into the entities that took part in it.
+type Death struct {
+	// Time is when the character died.
+	Time time.Time `json:"time"`
+
+	// Level is the level the character had when it died.
+	Level int `json:"level"`
+
+	// Killers is a list of the entities that killed the character.
+	Killers []Killer `json:"killers"`
+
+	// Assists is a list of the players that assisted in the death of the
+	// character, without dealing damage.
+	Assists []Killer `json:"assists,omitempty"`
+
+	// Reason is the death sentence as displayed by tibia.com.
+	Reason string `json:"reason"`
+}
+
+// Killer represents an entity that took part in the death of a character.
+type Killer struct {
+	// Name is the name of the killer, without any leading article.
+	//
+	// For summons, Name is the name of the summoned creature, i.e.
+	// "fire elemental".
+	Name string `json:"name"`
+
+	// Type is the kind of entity the killer is.
+	Type KillerType `json:"type"`
+
+	// Summoner is the name of the player that summoned the killer.
+	//
+	// Summoner is only set if Type is KillerTypeSummon.
+	Summoner string `json:"summoner,omitempty"`
+}
+
+// AccountInformation represents the information of the account of a character
+// displayed by tibia.com.
+type AccountInformation struct {
+	// Created is when the account was created.
+	Created time.Time `json:"created"`
+
+	// Position is the position of the account owner at CipSoft, i.e. "Tutor".
+	//
+	// Position is empty for regular accounts.
+	Position string `json:"position,omitempty"`
+}
+
+// AccountBadge represents a badge displayed by an account.
+type AccountBadge struct {
+	// Name is the name of the badge.
+	Name string `json:"name"`
+
+	// Description is the description of the badge.
+	Description string `json:"description"`
+
+	// ImageURL is the URL to the image of the badge.
+	ImageURL string `json:"image_url"`
+}
+
+// DisplayedAchievement represents an achievement displayed on the page of a
+// character.
+type DisplayedAchievement struct {
+	// Name is the name of the achievement.
+	Name string `json:"name"`
+
+	// Grade is the grade of the achievement, from 1 to 3.
+	Grade int `json:"grade"`
+
+	// Secret reports whether the achievement is a secret achievement.
+	Secret bool `json:"secret"`
+}
+
+// OtherCharacter represents a character of the same account as another
+// character.
+type OtherCharacter struct {
+	// Name is the name of the character.
+	Name string `json:"name"`
+
+	// World is the world the character is on.
+	World string `json:"world"`
+
+	// Main reports whether the character is the main character of the
+	// account.
+	Main bool `json:"main"`
+
+	// Traded reports whether the character was traded in the Char Bazaar
+	// recently.
+	Traded bool `json:"traded"`
+
+	// Status is the online status of the character.
+	Status OnlineStatus `json:"status"`
+
+	// Deletion is the deletion status of the character.
+	Deletion DeletionStatus `json:"deletion"`
+}
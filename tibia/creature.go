@@ -0,0 +1,65 @@
+package tibia
+
+// CreatureOverview represents the information about a creature displayed on
+// the tibia.com Creatures Library page.
+type CreatureOverview struct {
+	// Name is the name of the creature, as displayed by the library, i.e.
+	// "Dragon Lords".
+	Name string `json:"name"`
+
+	// Race is the identifier tibia.com uses for the creature, i.e.
+	// "dragonlord".
+	Race string `json:"race"`
+
+	// ImageURL is the URL to the image of the creature.
+	ImageURL string `json:"image_url"`
+}
+
+// Creature represents the information about a creature displayed on its
+// tibia.com Creatures Library page.
+type Creature struct {
+	// Name is the name of the creature, as displayed by the library, i.e.
+	// "Dragon Lords".
+	Name string `json:"name"`
+
+	// Race is the identifier tibia.com uses for the creature, i.e.
+	// "dragonlord".
+	Race string `json:"race"`
+
+	// ImageURL is the URL to the image of the creature.
+	ImageURL string `json:"image_url"`
+
+	// Description is the description of the creature, with paragraphs
+	// separated by "\n".
+	Description string `json:"description"`
+
+	// Hitpoints is the amount of hitpoints of the creature.
+	Hitpoints int `json:"hitpoints"`
+
+	// Experience is the amount of experience points the creature yields.
+	Experience int `json:"experience"`
+
+	// Immunities is a list of the damage types the creature is immune to,
+	// i.e. "fire".
+	Immunities []string `json:"immunities,omitempty"`
+
+	// Strengths is a list of the damage types the creature is strong
+	// against.
+	Strengths []string `json:"strengths,omitempty"`
+
+	// Weaknesses is a list of the damage types the creature is weak against.
+	Weaknesses []string `json:"weaknesses,omitempty"`
+
+	// Behaviour is the text describing the hitpoints, the immunities, the
+	// strengths and the weaknesses of the creature, as displayed by tibia.com.
+	Behaviour string `json:"behaviour"`
+
+	// Loot is the text describing the items the creature carries.
+	Loot string `json:"loot,omitempty"`
+
+	// Location is the text describing where the creature can be found.
+	Location string `json:"location,omitempty"`
+
+	// IsBoosted reports whether the creature is today's boosted creature.
+	IsBoosted bool `json:"is_boosted"`
+}
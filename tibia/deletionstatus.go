@@ -0,0 +1,148 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DeletionStatusFromString converts a string representation of a deletion
+// status to its corresponding DeletionStatus.
+//
+// This conversion allows you to work with deletion statuses in a more
+// convenient and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known deletion status values. If a match is found, the corresponding
+// DeletionStatus is returned along with a nil error.
+//
+// If the provided string does not match any known deletion status values, an
+// ErrUnknownDeletionStatus is returned.
+//
+// Strings representing the integer value of a DeletionStatus (i.e. "2" for
+// Deleted) will also be parsed into their corresponding DeletionStatus.
+func DeletionStatusFromString(ds string) (DeletionStatus, error) {
+	switch strings.ToLower(ds) {
+	case "active", "not deleted", "0":
+		return DeletionStatusActive, nil
+	case "scheduled", "scheduled for deletion", "will be deleted", "1":
+		return DeletionStatusScheduled, nil
+	case "deleted", "2":
+		return DeletionStatusDeleted, nil
+	default:
+		return DeletionStatus{}, ErrUnknownDeletionStatus
+	}
+}
+
+// DeletionStatusFromInt converts an integer representation of a deletion status
+// to its corresponding DeletionStatus.
+//
+// This conversion allows you to work with deletion statuses in a more
+// convenient and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// deletion status values. If a match is found, the corresponding DeletionStatus
+// is returned along with a nil error.
+//
+// If the provided integer does not match any known deletion status values, an
+// ErrUnknownDeletionStatus is returned.
+func DeletionStatusFromInt(ds int) (DeletionStatus, error) {
+	switch ds {
+	case 0:
+		return DeletionStatusActive, nil
+	case 1:
+		return DeletionStatusScheduled, nil
+	case 2:
+		return DeletionStatusDeleted, nil
+	default:
+		return DeletionStatus{}, ErrUnknownDeletionStatus
+	}
+}
+
+// DeletionStatus represents whether a character was deleted by its owner.
+//
+// Characters are not deleted right away. They are scheduled for deletion first,
+// and only deleted once the deletion date is reached.
+type DeletionStatus struct {
+	ds int
+}
+
+var (
+	// DeletionStatusActive represents a character that is not deleted.
+	DeletionStatusActive = DeletionStatus{0}
+
+	// DeletionStatusScheduled represents a character that is scheduled for
+	// deletion.
+	DeletionStatusScheduled = DeletionStatus{1}
+
+	// DeletionStatusDeleted represents a character that was deleted.
+	DeletionStatusDeleted = DeletionStatus{2}
+)
+
+// ID returns the integer representation of the DeletionStatus.
+//
+// It can be used to access the numerical representation of the DeletionStatus
+// when needed.
+func (ds DeletionStatus) ID() int {
+	return ds.ds
+}
+
+// String returns the string representation of the DeletionStatus.
+func (ds DeletionStatus) String() string {
+	switch ds {
+	case DeletionStatusActive:
+		return "Active"
+	case DeletionStatusScheduled:
+		return "Scheduled"
+	case DeletionStatusDeleted:
+		return "Deleted"
+	default:
+		panic("unknown ds")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (ds *DeletionStatus) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal deletion status: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return ds.unmarshalFromString(v)
+	case float64:
+		return ds.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into deletion status", v)
+	}
+}
+
+func (ds *DeletionStatus) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_ds, err := DeletionStatusFromString(data)
+	if err != nil {
+		return fmt.Errorf("deletion status unmarshal: %w", err)
+	}
+
+	*ds = _ds
+	return nil
+}
+
+func (ds *DeletionStatus) unmarshalFromInt(data int) error {
+	_ds, err := DeletionStatusFromInt(data)
+	if err != nil {
+		return fmt.Errorf("deletion status unmarshal: %w", err)
+	}
+
+	*ds = _ds
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (ds DeletionStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + ds.String() + `"`), nil
+}
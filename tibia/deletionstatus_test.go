@@ -0,0 +1,130 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestDeletionStatusJsonMarshal(t *testing.T) {
+	type Test struct {
+		DS DeletionStatus `json:"deletion_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "active",
+			input: Test{DeletionStatusActive},
+			want:  []byte(`{"deletion_status":"Active"}`),
+		},
+		{
+			name:  "scheduled",
+			input: Test{DeletionStatusScheduled},
+			want:  []byte(`{"deletion_status":"Scheduled"}`),
+		},
+		{
+			name:  "deleted",
+			input: Test{DeletionStatusDeleted},
+			want:  []byte(`{"deletion_status":"Deleted"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestDeletionStatusJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		DS DeletionStatus `json:"deletion_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "active",
+			want:  Test{DeletionStatusActive},
+			input: []byte(`{"deletion_status":"Active"}`),
+		},
+		{
+			name:  "scheduled",
+			want:  Test{DeletionStatusScheduled},
+			input: []byte(`{"deletion_status":"Scheduled"}`),
+		},
+		{
+			name:  "deleted",
+			want:  Test{DeletionStatusDeleted},
+			input: []byte(`{"deletion_status":"Deleted"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "active str int",
+			want:  Test{DeletionStatusActive},
+			input: []byte(`{"deletion_status":"0"}`),
+		},
+		{
+			name:  "scheduled str int",
+			want:  Test{DeletionStatusScheduled},
+			input: []byte(`{"deletion_status":"1"}`),
+		},
+		{
+			name:  "deleted str int",
+			want:  Test{DeletionStatusDeleted},
+			input: []byte(`{"deletion_status":"2"}`),
+		},
+		{
+			name:  "active int",
+			want:  Test{DeletionStatusActive},
+			input: []byte(`{"deletion_status":0}`),
+		},
+		{
+			name:  "scheduled int",
+			want:  Test{DeletionStatusScheduled},
+			input: []byte(`{"deletion_status":1}`),
+		},
+		{
+			name:  "deleted int",
+			want:  Test{DeletionStatusDeleted},
+			input: []byte(`{"deletion_status":2}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var ds Test
+			if err := json.Unmarshal(tc.input, &ds); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if ds != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, ds,
+				)
+				return
+			}
+		})
+	}
+}
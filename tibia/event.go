@@ -0,0 +1,70 @@
+package tibia
+
+import "time"
+
+// EventCalendar represents the events of a month.
+//
+// The EventCalendar struct contains the month and the events displayed on its
+// calendar. This information is typically obtained from the tibia.com Event
+// Calendar page.
+type EventCalendar struct {
+	// Year is the year of the calendar.
+	Year int `json:"year"`
+
+	// Month is the month of the calendar.
+	Month time.Month `json:"month"`
+
+	// Events is a list of the events displayed on the calendar, sorted by
+	// their start date.
+	Events []Event `json:"events"`
+}
+
+// ActiveOn returns the events that are active on the date of day.
+func (ec EventCalendar) ActiveOn(day time.Time) []Event {
+	var events []Event
+	for _, e := range ec.Events {
+		if e.IsActive(day) {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// Event represents an event of the tibia.com Event Calendar, such as a double
+// experience weekend or a rapid respawn event.
+//
+// tibia.com displays the days of the previous and of the next month that
+// share a week with the displayed month, so an event may start before or end
+// after the month of the calendar. Events that last longer than that are cut
+// at the edges of the calendar.
+//
+// An event that happens more than once in the same calendar, i.e. every
+// weekend, is reported as one Event for every span of consecutive days.
+type Event struct {
+	// Name is the name of the event.
+	Name string `json:"name"`
+
+	// Description is the description of the event.
+	Description string `json:"description"`
+
+	// Start is the first day the event is active.
+	Start time.Time `json:"start"`
+
+	// End is the last day the event is active.
+	End time.Time `json:"end"`
+
+	// Days is a list of every day the event is active, from Start to End.
+	Days []time.Time `json:"days"`
+}
+
+// IsActive reports whether the event is active on the date of day.
+func (e Event) IsActive(day time.Time) bool {
+	y, m, d := day.Date()
+	for _, active := range e.Days {
+		ay, am, ad := active.Date()
+		if ay == y && am == m && ad == d {
+			return true
+		}
+	}
+	return false
+}
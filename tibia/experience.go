@@ -0,0 +1,113 @@
+package tibia
+
+import "math"
+
+// MaxExpLevel is the highest level whose amount of experience points fits in
+// a 64-bit int. Higher levels are out of the range of ExpForLevel and
+// LevelForExp.
+const MaxExpLevel = 821009
+
+// ExperienceLevel represents an entry of the tibia.com Experience Table.
+type ExperienceLevel struct {
+	// Level is the level of the entry.
+	Level int `json:"level"`
+
+	// Experience is the amount of experience points required to reach the
+	// level.
+	Experience int `json:"experience"`
+}
+
+// ExpForLevel returns the amount of experience points required to reach the
+// given level.
+//
+// The amount follows the formula used by tibia.com on its Experience Table,
+// 50/3 * (level³ - 6level² + 17level - 12). Levels below 2 require no
+// experience points and levels above MaxExpLevel are treated as MaxExpLevel.
+func ExpForLevel(level int) int {
+	if level < 2 {
+		return 0
+	}
+
+	if level > MaxExpLevel {
+		level = MaxExpLevel
+	}
+
+	// level³ - 6level² + 17level - 12 is always a multiple of 3, so the
+	// division is exact. Dividing before multiplying keeps the result from
+	// overflowing up to MaxExpLevel.
+	return 50 * ((level*level*level - 6*level*level + 17*level - 12) / 3)
+}
+
+// LevelForExp returns the level of a character with the given amount of
+// experience points.
+//
+// Amounts below 0 are treated as 0, which is level 1. The returned level is
+// never higher than MaxExpLevel.
+func LevelForExp(exp int) int {
+	if exp <= 0 {
+		return 1
+	}
+
+	// For high levels, the experience is close to 50/3 * level³, so the cube
+	// root gives a close estimate, which is then adjusted.
+	level := int(math.Cbrt(float64(exp)*3/50)) + 2
+	if level > MaxExpLevel {
+		level = MaxExpLevel
+	}
+	for level > 1 && ExpForLevel(level) > exp {
+		level--
+	}
+	for level < MaxExpLevel && ExpForLevel(level+1) <= exp {
+		level++
+	}
+
+	return level
+}
+
+// ExpToNextLevel returns the amount of experience points a character with the
+// given amount of experience points needs to reach the next level.
+//
+// Characters at MaxExpLevel need no more experience points.
+func ExpToNextLevel(exp int) int {
+	if exp < 0 {
+		exp = 0
+	}
+
+	level := LevelForExp(exp)
+	if level >= MaxExpLevel {
+		return 0
+	}
+
+	return ExpForLevel(level+1) - exp
+}
+
+// SharedExpRange returns the lowest and the highest levels a character of the
+// given level can share experience with.
+//
+// Experience can only be shared in a party if the lowest level member is at
+// least two thirds of the level of the highest level member.
+func SharedExpRange(level int) (min, max int) {
+	// min is two thirds of the level, rounded up, and max is three halves of
+	// the level, rounded down.
+	return (2*level + 2) / 3, 3 * level / 2
+}
+
+// CanShareExp reports whether a party whose members have the given levels can
+// share experience, considering only their levels.
+func CanShareExp(levels ...int) bool {
+	if len(levels) == 0 {
+		return true
+	}
+
+	lowest, highest := levels[0], levels[0]
+	for _, level := range levels[1:] {
+		if level < lowest {
+			lowest = level
+		}
+		if level > highest {
+			highest = level
+		}
+	}
+
+	return 3*lowest >= 2*highest
+}
@@ -0,0 +1,121 @@
+package tibia
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExpForLevel(t *testing.T) {
+	for level, want := range map[int]int{
+		0:    0,
+		1:    0,
+		2:    100,
+		8:    4200,
+		100:  15694800,
+		1000: 16566949800,
+
+		600000:          3599964000169999800,
+		MaxExpLevel:     9223363599763206400,
+		MaxExpLevel + 1: 9223363599763206400,
+	} {
+		if got := ExpForLevel(level); got != want {
+			t.Errorf(
+				"Wrong experience for level %d\nwant: %d\ngot: %d",
+				level, want, got,
+			)
+		}
+	}
+}
+
+func TestLevelForExp(t *testing.T) {
+	for exp, want := range map[int]int{
+		-1:          1,
+		0:           1,
+		99:          1,
+		100:         2,
+		4199:        7,
+		4200:        8,
+		15694800:    100,
+		16566949799: 999,
+		16566949800: 1000,
+
+		math.MaxInt64 / 10:  381079,
+		9223363599763206399: MaxExpLevel - 1,
+		9223363599763206400: MaxExpLevel,
+		math.MaxInt64:       MaxExpLevel,
+	} {
+		if got := LevelForExp(exp); got != want {
+			t.Errorf(
+				"Wrong level for %d experience\nwant: %d\ngot: %d",
+				exp, want, got,
+			)
+		}
+	}
+}
+
+func TestExpToNextLevel(t *testing.T) {
+	for exp, want := range map[int]int{
+		0:    100,
+		50:   50,
+		100:  100,
+		4200: 2200,
+
+		math.MaxInt64: 0,
+	} {
+		if got := ExpToNextLevel(exp); got != want {
+			t.Errorf(
+				"Wrong experience to next level for %d\nwant: %d\ngot: %d",
+				exp, want, got,
+			)
+		}
+	}
+}
+
+func TestSharedExpRange(t *testing.T) {
+	for level, want := range map[int][2]int{
+		1:   {1, 1},
+		8:   {6, 12},
+		100: {67, 150},
+		101: {68, 151},
+	} {
+		min, max := SharedExpRange(level)
+		if min != want[0] || max != want[1] {
+			t.Errorf(
+				"Wrong shared range for level %d\nwant: %v\ngot: %v",
+				level, want, [2]int{min, max},
+			)
+		}
+
+		if !CanShareExp(level, min) || !CanShareExp(level, max) {
+			t.Errorf("level %d must share with %d and %d", level, min, max)
+		}
+
+		if level > 1 && CanShareExp(level, min-1) {
+			t.Errorf("level %d must not share with %d", level, min-1)
+		}
+
+		if CanShareExp(level, max+1) {
+			t.Errorf("level %d must not share with %d", level, max+1)
+		}
+	}
+}
+
+func TestCanShareExp(t *testing.T) {
+	for _, tc := range []struct {
+		levels []int
+		want   bool
+	}{
+		{nil, true},
+		{[]int{50}, true},
+		{[]int{100, 67, 90}, true},
+		{[]int{100, 67, 101}, false},
+		{[]int{151, 100}, false},
+	} {
+		if got := CanShareExp(tc.levels...); got != tc.want {
+			t.Errorf(
+				"Wrong share for levels %v\nwant: %t\ngot: %t",
+				tc.levels, tc.want, got,
+			)
+		}
+	}
+}
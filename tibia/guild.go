@@ -0,0 +1,237 @@
+package tibia
+
+import "time"
+
+// Guilds represents the guilds of a world.
+//
+// The Guilds struct contains the active guilds of a world and the guilds that
+// are still in course of formation. This information is typically obtained
+// from the tibia.com Guilds page.
+type Guilds struct {
+	// World is the world the guilds are on.
+	World string `json:"world"`
+
+	// Active is a list of the active guilds of the world.
+	Active []GuildOverview `json:"active"`
+
+	// Formation is a list of the guilds of the world that are in course of
+	// formation.
+	Formation []GuildOverview `json:"formation"`
+}
+
+// GuildOverview represents the information about a guild displayed on the
+// tibia.com Guilds page.
+type GuildOverview struct {
+	// Name is the name of the guild.
+	Name string `json:"name"`
+
+	// LogoURL is the URL to the logo of the guild.
+	LogoURL string `json:"logo_url"`
+
+	// Description is the description of the guild.
+	Description string `json:"description,omitempty"`
+}
+
+// Guild represents the information about a guild displayed on the tibia.com
+// Guild page.
+type Guild struct {
+	// Name is the name of the guild.
+	Name string `json:"name"`
+
+	// World is the world the guild is on.
+	World string `json:"world"`
+
+	// LogoURL is the URL to the logo of the guild.
+	LogoURL string `json:"logo_url"`
+
+	// Description is the description of the guild.
+	Description string `json:"description,omitempty"`
+
+	// Founded is when the guild was founded.
+	Founded time.Time `json:"founded"`
+
+	// Active reports whether the guild is active. If false, the guild is in
+	// course of formation.
+	Active bool `json:"active"`
+
+	// OpenApplications reports whether the guild is open for applications.
+	OpenApplications bool `json:"open_applications"`
+
+	// Homepage is the official homepage of the guild.
+	Homepage string `json:"homepage,omitempty"`
+
+	// Guildhall is the guildhall of the guild.
+	//
+	// Guildhall is nil if the guild does not have a guildhall.
+	Guildhall *Guildhall `json:"guildhall,omitempty"`
+
+	// DisbandDate is when the guild will be disbanded.
+	//
+	// DisbandDate is only set if the guild is about to be disbanded.
+	DisbandDate time.Time `json:"disband_date"`
+
+	// DisbandCondition is the condition that must be fulfilled before
+	// DisbandDate for the guild not to be disbanded, i.e.
+	// "unless one more vice leader is appointed".
+	DisbandCondition string `json:"disband_condition,omitempty"`
+
+	// Ranks is a list of the ranks of the guild and their members, in the
+	// same order they are displayed on the page.
+	Ranks []GuildRank `json:"ranks"`
+
+	// Invites is a list of the characters invited to the guild.
+	Invites []GuildInvite `json:"invites"`
+}
+
+// Members returns all the members of the guild, ordered by rank.
+func (g Guild) Members() []GuildMember {
+	var members []GuildMember
+	for _, rank := range g.Ranks {
+		members = append(members, rank.Members...)
+	}
+	return members
+}
+
+// OnlineMembers returns the members of the guild that are online, ordered by
+// rank.
+func (g Guild) OnlineMembers() []GuildMember {
+	var members []GuildMember
+	for _, rank := range g.Ranks {
+		for _, member := range rank.Members {
+			if member.Status == OnlineStatusOnline {
+				members = append(members, member)
+			}
+		}
+	}
+	return members
+}
+
+// Guildhall represents the guildhall of a guild.
+type Guildhall struct {
+	// Name is the name of the guildhall.
+	Name string `json:"name"`
+
+	// PaidUntil is the date the rent of the guildhall is paid until.
+	PaidUntil time.Time `json:"paid_until"`
+}
+
+// GuildRank represents a rank of a guild and its members.
+type GuildRank struct {
+	// Name is the name of the rank.
+	Name string `json:"name"`
+
+	// Members is a list of the members that have the rank.
+	Members []GuildMember `json:"members"`
+}
+
+// GuildMember represents a member of a guild.
+type GuildMember struct {
+	// Name is the name of the member.
+	Name string `json:"name"`
+
+	// Title is the guild title of the member.
+	Title string `json:"title,omitempty"`
+
+	// Vocation is the vocation of the member.
+	Vocation Vocation `json:"vocation"`
+
+	// Level is the level of the member.
+	Level int `json:"level"`
+
+	// Joined is the date the member joined the guild.
+	Joined time.Time `json:"joined"`
+
+	// Status is the online status of the member.
+	Status OnlineStatus `json:"status"`
+}
+
+// GuildInvite represents a character that was invited to a guild.
+type GuildInvite struct {
+	// Name is the name of the invited character.
+	Name string `json:"name"`
+
+	// Date is the date the character was invited.
+	Date time.Time `json:"date"`
+}
+
+// GuildWars represents the wars of a guild.
+//
+// The GuildWars struct contains the wars a guild is currently involved in and
+// the wars it was involved in the past. This information is typically obtained
+// from the tibia.com Guild Wars page.
+type GuildWars struct {
+	// Guild is the name of the guild.
+	Guild string `json:"guild"`
+
+	// Active is a list of the wars the guild is currently involved in.
+	Active []GuildWar `json:"active"`
+
+	// History is a list of the wars the guild was involved in the past.
+	History []GuildWar `json:"history"`
+}
+
+// GuildWar represents a war between two guilds, from the perspective of one
+// of them.
+type GuildWar struct {
+	// Opponent is the name of the opposing guild.
+	Opponent string `json:"opponent"`
+
+	// Score is the amount of kills the guild has against Opponent.
+	Score int `json:"score"`
+
+	// OpponentScore is the amount of kills Opponent has against the guild.
+	OpponentScore int `json:"opponent_score"`
+
+	// KillLimit is the amount of kills needed to win the war.
+	KillLimit int `json:"kill_limit"`
+
+	// Fee is the amount of gold coins paid by the guild to declare or
+	// accept the war.
+	Fee int `json:"fee"`
+
+	// OpponentFee is the amount of gold coins paid by Opponent to declare or
+	// accept the war.
+	OpponentFee int `json:"opponent_fee"`
+
+	// Started is the date the war started.
+	Started time.Time `json:"started"`
+
+	// Ended is the date the war ended or, if the war is still active, the
+	// date it will end if KillLimit is not reached before.
+	Ended time.Time `json:"ended"`
+
+	// EndReason is why the war ended.
+	//
+	// EndReason is GuildWarEndReasonNone if the war is still active.
+	EndReason GuildWarEndReason `json:"end_reason"`
+
+	// Winner is the name of the guild that won the war.
+	//
+	// Winner is empty if the war is still active or ended in a draw.
+	Winner string `json:"winner,omitempty"`
+}
+
+// GuildEvents represents the event log of a guild.
+//
+// This information is typically obtained from the tibia.com Guild Events
+// page.
+type GuildEvents struct {
+	// Guild is the name of the guild.
+	Guild string `json:"guild"`
+
+	// Events is a list of the events of the guild, most recent first.
+	Events []GuildEvent `json:"events"`
+}
+
+// GuildEvent represents an entry of the event log of a guild.
+type GuildEvent struct {
+	// Time is when the event happened.
+	Time time.Time `json:"time"`
+
+	// Message is the description of the event, i.e.
+	// "Kharsek Valdor invited Nandor.".
+	Message string `json:"message"`
+
+	// Characters is a list of the characters linked in Message.
+	Characters []string `json:"characters,omitempty"`
+}
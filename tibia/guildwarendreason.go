@@ -0,0 +1,166 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GuildWarEndReasonFromString converts a string representation of a guild war
+// end reason to its corresponding GuildWarEndReason.
+//
+// This conversion allows you to work with guild war end reasons in a more
+// convenient and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known guild war end reason values. If a match is found, the
+// corresponding GuildWarEndReason is returned along with a nil error.
+//
+// If the provided string does not match any known guild war end reason values,
+// an ErrUnknownGuildWarEndReason is returned.
+//
+// Strings representing the integer value of a GuildWarEndReason (i.e. "3" for
+// Surrender) will also be parsed into their corresponding GuildWarEndReason.
+func GuildWarEndReasonFromString(wr string) (GuildWarEndReason, error) {
+	switch strings.ToLower(wr) {
+	case "none", "ongoing", "0":
+		return GuildWarEndReasonNone, nil
+	case "kill limit", "kill limit reached", "1":
+		return GuildWarEndReasonKillLimit, nil
+	case "duration", "duration expired", "2":
+		return GuildWarEndReasonDuration, nil
+	case "surrender", "surrendered", "3":
+		return GuildWarEndReasonSurrender, nil
+	case "disband", "disbanded", "4":
+		return GuildWarEndReasonDisband, nil
+	default:
+		return GuildWarEndReason{}, ErrUnknownGuildWarEndReason
+	}
+}
+
+// GuildWarEndReasonFromInt converts an integer representation of a guild war
+// end reason to its corresponding GuildWarEndReason.
+//
+// This conversion allows you to work with guild war end reasons in a more
+// convenient and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// guild war end reason values. If a match is found, the corresponding
+// GuildWarEndReason is returned along with a nil error.
+//
+// If the provided integer does not match any known guild war end reason values,
+// an ErrUnknownGuildWarEndReason is returned.
+func GuildWarEndReasonFromInt(wr int) (GuildWarEndReason, error) {
+	switch wr {
+	case 0:
+		return GuildWarEndReasonNone, nil
+	case 1:
+		return GuildWarEndReasonKillLimit, nil
+	case 2:
+		return GuildWarEndReasonDuration, nil
+	case 3:
+		return GuildWarEndReasonSurrender, nil
+	case 4:
+		return GuildWarEndReasonDisband, nil
+	default:
+		return GuildWarEndReason{}, ErrUnknownGuildWarEndReason
+	}
+}
+
+// GuildWarEndReason represents why a guild war ended.
+type GuildWarEndReason struct {
+	wr int
+}
+
+var (
+	// GuildWarEndReasonNone represents a guild war that did not end yet.
+	GuildWarEndReasonNone = GuildWarEndReason{0}
+
+	// GuildWarEndReasonKillLimit represents a guild war that ended because one
+	// of the guilds reached the kill limit.
+	GuildWarEndReasonKillLimit = GuildWarEndReason{1}
+
+	// GuildWarEndReasonDuration represents a guild war that ended because its
+	// duration expired.
+	GuildWarEndReasonDuration = GuildWarEndReason{2}
+
+	// GuildWarEndReasonSurrender represents a guild war that ended because one
+	// of the guilds surrendered.
+	GuildWarEndReasonSurrender = GuildWarEndReason{3}
+
+	// GuildWarEndReasonDisband represents a guild war that ended because one of
+	// the guilds was disbanded.
+	GuildWarEndReasonDisband = GuildWarEndReason{4}
+)
+
+// ID returns the integer representation of the GuildWarEndReason.
+//
+// It can be used to access the numerical representation of the
+// GuildWarEndReason when needed.
+func (wr GuildWarEndReason) ID() int {
+	return wr.wr
+}
+
+// String returns the string representation of the GuildWarEndReason.
+func (wr GuildWarEndReason) String() string {
+	switch wr {
+	case GuildWarEndReasonNone:
+		return "None"
+	case GuildWarEndReasonKillLimit:
+		return "Kill Limit"
+	case GuildWarEndReasonDuration:
+		return "Duration"
+	case GuildWarEndReasonSurrender:
+		return "Surrender"
+	case GuildWarEndReasonDisband:
+		return "Disband"
+	default:
+		panic("unknown wr")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (wr *GuildWarEndReason) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal guild war end reason: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return wr.unmarshalFromString(v)
+	case float64:
+		return wr.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into guild war end reason", v)
+	}
+}
+
+func (wr *GuildWarEndReason) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_wr, err := GuildWarEndReasonFromString(data)
+	if err != nil {
+		return fmt.Errorf("guild war end reason unmarshal: %w", err)
+	}
+
+	*wr = _wr
+	return nil
+}
+
+func (wr *GuildWarEndReason) unmarshalFromInt(data int) error {
+	_wr, err := GuildWarEndReasonFromInt(data)
+	if err != nil {
+		return fmt.Errorf("guild war end reason unmarshal: %w", err)
+	}
+
+	*wr = _wr
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (wr GuildWarEndReason) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + wr.String() + `"`), nil
+}
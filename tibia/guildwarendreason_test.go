@@ -0,0 +1,170 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestGuildWarEndReasonJsonMarshal(t *testing.T) {
+	type Test struct {
+		WR GuildWarEndReason `json:"guild_war_end_reason"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "none",
+			input: Test{GuildWarEndReasonNone},
+			want:  []byte(`{"guild_war_end_reason":"None"}`),
+		},
+		{
+			name:  "kill limit",
+			input: Test{GuildWarEndReasonKillLimit},
+			want:  []byte(`{"guild_war_end_reason":"Kill Limit"}`),
+		},
+		{
+			name:  "duration",
+			input: Test{GuildWarEndReasonDuration},
+			want:  []byte(`{"guild_war_end_reason":"Duration"}`),
+		},
+		{
+			name:  "surrender",
+			input: Test{GuildWarEndReasonSurrender},
+			want:  []byte(`{"guild_war_end_reason":"Surrender"}`),
+		},
+		{
+			name:  "disband",
+			input: Test{GuildWarEndReasonDisband},
+			want:  []byte(`{"guild_war_end_reason":"Disband"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestGuildWarEndReasonJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		WR GuildWarEndReason `json:"guild_war_end_reason"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "none",
+			want:  Test{GuildWarEndReasonNone},
+			input: []byte(`{"guild_war_end_reason":"None"}`),
+		},
+		{
+			name:  "kill limit",
+			want:  Test{GuildWarEndReasonKillLimit},
+			input: []byte(`{"guild_war_end_reason":"Kill Limit"}`),
+		},
+		{
+			name:  "duration",
+			want:  Test{GuildWarEndReasonDuration},
+			input: []byte(`{"guild_war_end_reason":"Duration"}`),
+		},
+		{
+			name:  "surrender",
+			want:  Test{GuildWarEndReasonSurrender},
+			input: []byte(`{"guild_war_end_reason":"Surrender"}`),
+		},
+		{
+			name:  "disband",
+			want:  Test{GuildWarEndReasonDisband},
+			input: []byte(`{"guild_war_end_reason":"Disband"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "none str int",
+			want:  Test{GuildWarEndReasonNone},
+			input: []byte(`{"guild_war_end_reason":"0"}`),
+		},
+		{
+			name:  "kill limit str int",
+			want:  Test{GuildWarEndReasonKillLimit},
+			input: []byte(`{"guild_war_end_reason":"1"}`),
+		},
+		{
+			name:  "duration str int",
+			want:  Test{GuildWarEndReasonDuration},
+			input: []byte(`{"guild_war_end_reason":"2"}`),
+		},
+		{
+			name:  "surrender str int",
+			want:  Test{GuildWarEndReasonSurrender},
+			input: []byte(`{"guild_war_end_reason":"3"}`),
+		},
+		{
+			name:  "disband str int",
+			want:  Test{GuildWarEndReasonDisband},
+			input: []byte(`{"guild_war_end_reason":"4"}`),
+		},
+		{
+			name:  "none int",
+			want:  Test{GuildWarEndReasonNone},
+			input: []byte(`{"guild_war_end_reason":0}`),
+		},
+		{
+			name:  "kill limit int",
+			want:  Test{GuildWarEndReasonKillLimit},
+			input: []byte(`{"guild_war_end_reason":1}`),
+		},
+		{
+			name:  "duration int",
+			want:  Test{GuildWarEndReasonDuration},
+			input: []byte(`{"guild_war_end_reason":2}`),
+		},
+		{
+			name:  "surrender int",
+			want:  Test{GuildWarEndReasonSurrender},
+			input: []byte(`{"guild_war_end_reason":3}`),
+		},
+		{
+			name:  "disband int",
+			want:  Test{GuildWarEndReasonDisband},
+			input: []byte(`{"guild_war_end_reason":4}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var wr Test
+			if err := json.Unmarshal(tc.input, &wr); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if wr != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, wr,
+				)
+				return
+			}
+		})
+	}
+}
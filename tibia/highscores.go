@@ -0,0 +1,65 @@
+package tibia
+
+// MaxHighscoresPage is the last page of highscores displayed by tibia.com.
+//
+// tibia.com only displays the top 1000 entries of each highscore list, split
+// in pages of 50 entries.
+const MaxHighscoresPage = 20
+
+// Highscores represents a page of a highscore list.
+//
+// The Highscores struct contains the filters used to build the list, the
+// pagination information and the entries of the page. This information is
+// typically obtained from the tibia.com Highscores page.
+type Highscores struct {
+	// World is the world the highscores are from.
+	//
+	// World is empty if the highscores are from all worlds.
+	World string `json:"world,omitempty"`
+
+	// Category is the category of the highscores.
+	Category HighscoreCategory `json:"category"`
+
+	// Vocation is the vocation the highscores are filtered by.
+	Vocation Vocation `json:"vocation"`
+
+	// Page is the current page.
+	Page int `json:"page"`
+
+	// TotalPages is the amount of pages of the highscore list.
+	TotalPages int `json:"total_pages"`
+
+	// TotalResults is the amount of entries of the highscore list, across all
+	// pages.
+	TotalResults int `json:"total_results"`
+
+	// Entries is a list of the entries of the current page.
+	Entries []HighscoreEntry `json:"entries"`
+}
+
+// HighscoreEntry represents an entry of a highscore list.
+type HighscoreEntry struct {
+	// Rank is the position of the character on the highscore list.
+	Rank int `json:"rank"`
+
+	// Name is the name of the character.
+	Name string `json:"name"`
+
+	// Vocation is the vocation of the character.
+	Vocation Vocation `json:"vocation"`
+
+	// World is the world of the character.
+	World string `json:"world"`
+
+	// Level is the level of the character.
+	Level int `json:"level"`
+
+	// Value is the value of the character on the highscore category, i.e.
+	// experience points or skill level.
+	Value int `json:"value"`
+
+	// LoyaltyTitle is the loyalty title of the character.
+	//
+	// LoyaltyTitle is only set on the Loyalty Points category.
+	LoyaltyTitle string `json:"loyalty_title,omitempty"`
+}
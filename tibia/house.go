@@ -0,0 +1,134 @@
+package tibia
+
+import "time"
+
+// Houses represents the houses of a town.
+//
+// The Houses struct contains the filters used to list the houses and the
+// houses that matched them. This information is typically obtained from the
+// tibia.com Houses page.
+type Houses struct {
+	// World is the world the houses are on.
+	World string `json:"world"`
+
+	// Town is the town the houses are in.
+	Town Town `json:"town"`
+
+	// Type is the type of the houses.
+	Type HouseType `json:"type"`
+
+	// Houses is a list of the houses that matched the filters.
+	Houses []HouseOverview `json:"houses"`
+}
+
+// HouseOverview represents the information about a house displayed on the
+// tibia.com Houses page.
+type HouseOverview struct {
+	// ID is the ID of the house.
+	ID int `json:"id"`
+
+	// Name is the name of the house.
+	Name string `json:"name"`
+
+	// Size is the size of the house, in square meters.
+	Size int `json:"size"`
+
+	// Rent is the monthly rent of the house, in gold coins.
+	Rent int `json:"rent"`
+
+	// Status is whether the house is rented or being auctioned.
+	Status HouseStatus `json:"status"`
+
+	// CurrentBid is the highest bid of the auction, in gold coins.
+	//
+	// CurrentBid is only set if Status is HouseStatusAuctioned and someone
+	// already placed a bid.
+	CurrentBid int `json:"current_bid,omitempty"`
+
+	// TimeLeft is the time left until the auction ends, as displayed by
+	// tibia.com, i.e. 5 days or 20 hours.
+	//
+	// TimeLeft is only set if Status is HouseStatusAuctioned and someone
+	// already placed a bid.
+	TimeLeft time.Duration `json:"time_left,omitempty"`
+}
+
+// House represents the information about a house displayed on the tibia.com
+// House page.
+type House struct {
+	// ID is the ID of the house.
+	ID int `json:"id"`
+
+	// World is the world the house is on.
+	World string `json:"world"`
+
+	// Name is the name of the house.
+	Name string `json:"name"`
+
+	// ImageURL is the URL to the image of the house.
+	ImageURL string `json:"image_url"`
+
+	// Description is the description of the house.
+	Description string `json:"description,omitempty"`
+
+	// Beds is the amount of beds in the house.
+	Beds int `json:"beds"`
+
+	// Size is the size of the house, in square meters.
+	Size int `json:"size"`
+
+	// Rent is the monthly rent of the house, in gold coins.
+	Rent int `json:"rent"`
+
+	// Status is whether the house is rented or being auctioned.
+	Status HouseStatus `json:"status"`
+
+	// Owner is the name of the character that rents the house.
+	//
+	// Owner is only set if Status is HouseStatusRented.
+	Owner string `json:"owner,omitempty"`
+
+	// PaidUntil is the date the rent of the house is paid until.
+	//
+	// PaidUntil is only set if Status is HouseStatusRented.
+	PaidUntil time.Time `json:"paid_until"`
+
+	// Transfer is the pending transfer of the house.
+	//
+	// Transfer is nil if Owner is not moving out.
+	Transfer *HouseTransfer `json:"transfer,omitempty"`
+
+	// Auction is the auction of the house.
+	//
+	// Auction is only set if Status is HouseStatusAuctioned.
+	Auction *HouseAuction `json:"auction,omitempty"`
+}
+
+// HouseTransfer represents the owner of a house moving out of it.
+type HouseTransfer struct {
+	// Date is when the owner will move out.
+	Date time.Time `json:"date"`
+
+	// NewOwner is the name of the character the house will be passed to.
+	//
+	// NewOwner is empty if the house will not be passed to another
+	// character, in which case it will be auctioned again.
+	NewOwner string `json:"new_owner,omitempty"`
+
+	// Price is the amount of gold coins NewOwner will pay for the house.
+	Price int `json:"price,omitempty"`
+}
+
+// HouseAuction represents the auction of a house.
+type HouseAuction struct {
+	// End is when the auction ends.
+	End time.Time `json:"end"`
+
+	// HighestBid is the highest bid so far, in gold coins.
+	//
+	// HighestBid is 0 if no bid was submitted yet.
+	HighestBid int `json:"highest_bid"`
+
+	// Bidder is the name of the character that submitted HighestBid.
+	Bidder string `json:"bidder,omitempty"`
+}
@@ -0,0 +1,172 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// HouseStatusFromString converts a string representation of a house status to
+// its corresponding HouseStatus.
+//
+// This conversion allows you to work with house statuses in a more convenient
+// and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known house status values. If a match is found, the corresponding
+// HouseStatus is returned along with a nil error.
+//
+// If the provided string does not match any known house status values, an
+// ErrUnknownHouseStatus is returned.
+//
+// Strings representing the integer value of a HouseStatus (i.e. "1" for
+// Auctioned) will also be parsed into their corresponding HouseStatus.
+func HouseStatusFromString(hs string) (HouseStatus, error) {
+	switch strings.ToLower(hs) {
+	case "rented", "0":
+		return HouseStatusRented, nil
+	case "auctioned", "1":
+		return HouseStatusAuctioned, nil
+	default:
+		return HouseStatus{}, ErrUnknownHouseStatus
+	}
+}
+
+// HouseStatusFromInt converts an integer representation of a house status to
+// its corresponding HouseStatus.
+//
+// This conversion allows you to work with house statuses in a more convenient
+// and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// house status values. If a match is found, the corresponding HouseStatus is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known house status values, an
+// ErrUnknownHouseStatus is returned.
+func HouseStatusFromInt(hs int) (HouseStatus, error) {
+	switch hs {
+	case 0:
+		return HouseStatusRented, nil
+	case 1:
+		return HouseStatusAuctioned, nil
+	default:
+		return HouseStatus{}, ErrUnknownHouseStatus
+	}
+}
+
+// HouseStatus represents whether a house is rented or being auctioned.
+type HouseStatus struct {
+	hs int
+}
+
+var (
+	// HouseStatusRented represents a house that is rented by a character.
+	HouseStatusRented = HouseStatus{0}
+
+	// HouseStatusAuctioned represents a house that is being auctioned.
+	HouseStatusAuctioned = HouseStatus{1}
+)
+
+// ID returns the integer representation of the HouseStatus.
+//
+// It can be used to access the numerical representation of the HouseStatus when
+// needed.
+func (hs HouseStatus) ID() int {
+	return hs.hs
+}
+
+// QueryVal returns the query parameter value representation of the HouseStatus.
+//
+// The QueryVal method returns the string representation of the HouseStatus,
+// suitable for use as a query parameter value when making requests to tibia.com
+// endpoints that support filtering by a house status.
+//
+// Example usage:
+//
+//	hs := tibia.HouseStatusAuctioned
+//	vals := url.Values{}
+//	vals.Set(hs.QueryKey(), hs.QueryVal())
+func (hs HouseStatus) QueryVal() string {
+	switch hs {
+	case HouseStatusRented:
+		return "rented"
+	case HouseStatusAuctioned:
+		return "auctioned"
+	default:
+		panic("unknown hs")
+	}
+}
+
+// QueryKey returns the query parameter key for filtering by HouseStatus.
+//
+// The QueryKey method returns the string representation of the query parameter
+// key to be used when filtering by a house status in tibia.com requests. This
+// key can be appended to the query string to specify the desired house status.
+//
+// Example usage:
+//
+//	hs := tibia.HouseStatusAuctioned
+//	vals := url.Values{}
+//	vals.Set(hs.QueryKey(), hs.QueryVal())
+func (hs HouseStatus) QueryKey() string {
+	return "state"
+}
+
+// String returns the string representation of the HouseStatus.
+func (hs HouseStatus) String() string {
+	switch hs {
+	case HouseStatusRented:
+		return "Rented"
+	case HouseStatusAuctioned:
+		return "Auctioned"
+	default:
+		panic("unknown hs")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (hs *HouseStatus) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal house status: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return hs.unmarshalFromString(v)
+	case float64:
+		return hs.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into house status", v)
+	}
+}
+
+func (hs *HouseStatus) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_hs, err := HouseStatusFromString(data)
+	if err != nil {
+		return fmt.Errorf("house status unmarshal: %w", err)
+	}
+
+	*hs = _hs
+	return nil
+}
+
+func (hs *HouseStatus) unmarshalFromInt(data int) error {
+	_hs, err := HouseStatusFromInt(data)
+	if err != nil {
+		return fmt.Errorf("house status unmarshal: %w", err)
+	}
+
+	*hs = _hs
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (hs HouseStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + hs.String() + `"`), nil
+}
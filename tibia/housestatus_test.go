@@ -0,0 +1,110 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestHouseStatusJsonMarshal(t *testing.T) {
+	type Test struct {
+		HS HouseStatus `json:"house_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "rented",
+			input: Test{HouseStatusRented},
+			want:  []byte(`{"house_status":"Rented"}`),
+		},
+		{
+			name:  "auctioned",
+			input: Test{HouseStatusAuctioned},
+			want:  []byte(`{"house_status":"Auctioned"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestHouseStatusJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		HS HouseStatus `json:"house_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "rented",
+			want:  Test{HouseStatusRented},
+			input: []byte(`{"house_status":"Rented"}`),
+		},
+		{
+			name:  "auctioned",
+			want:  Test{HouseStatusAuctioned},
+			input: []byte(`{"house_status":"Auctioned"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "rented str int",
+			want:  Test{HouseStatusRented},
+			input: []byte(`{"house_status":"0"}`),
+		},
+		{
+			name:  "auctioned str int",
+			want:  Test{HouseStatusAuctioned},
+			input: []byte(`{"house_status":"1"}`),
+		},
+		{
+			name:  "rented int",
+			want:  Test{HouseStatusRented},
+			input: []byte(`{"house_status":0}`),
+		},
+		{
+			name:  "auctioned int",
+			want:  Test{HouseStatusAuctioned},
+			input: []byte(`{"house_status":1}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var hs Test
+			if err := json.Unmarshal(tc.input, &hs); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if hs != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, hs,
+				)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// HouseTypeFromString converts a string representation of a house type to its
+// corresponding HouseType.
+//
+// This conversion allows you to work with house types in a more convenient and
+// type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known house type values. If a match is found, the corresponding
+// HouseType is returned along with a nil error.
+//
+// If the provided string does not match any known house type values, an
+// ErrUnknownHouseType is returned.
+//
+// Strings representing the integer value of a HouseType (i.e. "1" for
+// Guildhall) will also be parsed into their corresponding HouseType.
+func HouseTypeFromString(ht string) (HouseType, error) {
+	switch strings.ToLower(ht) {
+	case "house", "houses", "houses and flats", "0":
+		return HouseTypeHouse, nil
+	case "guildhall", "guildhalls", "1":
+		return HouseTypeGuildhall, nil
+	default:
+		return HouseType{}, ErrUnknownHouseType
+	}
+}
+
+// HouseTypeFromInt converts an integer representation of a house type to its
+// corresponding HouseType.
+//
+// This conversion allows you to work with house types in a more convenient and
+// type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// house type values. If a match is found, the corresponding HouseType is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known house type values, an
+// ErrUnknownHouseType is returned.
+func HouseTypeFromInt(ht int) (HouseType, error) {
+	switch ht {
+	case 0:
+		return HouseTypeHouse, nil
+	case 1:
+		return HouseTypeGuildhall, nil
+	default:
+		return HouseType{}, ErrUnknownHouseType
+	}
+}
+
+// HouseType represents whether a house is a regular house or a guildhall.
+type HouseType struct {
+	ht int
+}
+
+var (
+	// HouseTypeHouse represents a regular house or flat.
+	HouseTypeHouse = HouseType{0}
+
+	// HouseTypeGuildhall represents a guildhall.
+	HouseTypeGuildhall = HouseType{1}
+)
+
+// ID returns the integer representation of the HouseType.
+//
+// It can be used to access the numerical representation of the HouseType when
+// needed.
+func (ht HouseType) ID() int {
+	return ht.ht
+}
+
+// QueryVal returns the query parameter value representation of the HouseType.
+//
+// The QueryVal method returns the string representation of the HouseType,
+// suitable for use as a query parameter value when making requests to tibia.com
+// endpoints that support filtering by a house type.
+//
+// Example usage:
+//
+//	ht := tibia.HouseTypeGuildhall
+//	vals := url.Values{}
+//	vals.Set(ht.QueryKey(), ht.QueryVal())
+func (ht HouseType) QueryVal() string {
+	switch ht {
+	case HouseTypeHouse:
+		return "houses"
+	case HouseTypeGuildhall:
+		return "guildhalls"
+	default:
+		panic("unknown ht")
+	}
+}
+
+// QueryKey returns the query parameter key for filtering by HouseType.
+//
+// The QueryKey method returns the string representation of the query parameter
+// key to be used when filtering by a house type in tibia.com requests. This key
+// can be appended to the query string to specify the desired house type.
+//
+// Example usage:
+//
+//	ht := tibia.HouseTypeGuildhall
+//	vals := url.Values{}
+//	vals.Set(ht.QueryKey(), ht.QueryVal())
+func (ht HouseType) QueryKey() string {
+	return "type"
+}
+
+// String returns the string representation of the HouseType.
+func (ht HouseType) String() string {
+	switch ht {
+	case HouseTypeHouse:
+		return "House"
+	case HouseTypeGuildhall:
+		return "Guildhall"
+	default:
+		panic("unknown ht")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (ht *HouseType) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal house type: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return ht.unmarshalFromString(v)
+	case float64:
+		return ht.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into house type", v)
+	}
+}
+
+func (ht *HouseType) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_ht, err := HouseTypeFromString(data)
+	if err != nil {
+		return fmt.Errorf("house type unmarshal: %w", err)
+	}
+
+	*ht = _ht
+	return nil
+}
+
+func (ht *HouseType) unmarshalFromInt(data int) error {
+	_ht, err := HouseTypeFromInt(data)
+	if err != nil {
+		return fmt.Errorf("house type unmarshal: %w", err)
+	}
+
+	*ht = _ht
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (ht HouseType) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + ht.String() + `"`), nil
+}
@@ -0,0 +1,110 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestHouseTypeJsonMarshal(t *testing.T) {
+	type Test struct {
+		HT HouseType `json:"house_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "house",
+			input: Test{HouseTypeHouse},
+			want:  []byte(`{"house_type":"House"}`),
+		},
+		{
+			name:  "guildhall",
+			input: Test{HouseTypeGuildhall},
+			want:  []byte(`{"house_type":"Guildhall"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestHouseTypeJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		HT HouseType `json:"house_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "house",
+			want:  Test{HouseTypeHouse},
+			input: []byte(`{"house_type":"House"}`),
+		},
+		{
+			name:  "guildhall",
+			want:  Test{HouseTypeGuildhall},
+			input: []byte(`{"house_type":"Guildhall"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "house str int",
+			want:  Test{HouseTypeHouse},
+			input: []byte(`{"house_type":"0"}`),
+		},
+		{
+			name:  "guildhall str int",
+			want:  Test{HouseTypeGuildhall},
+			input: []byte(`{"house_type":"1"}`),
+		},
+		{
+			name:  "house int",
+			want:  Test{HouseTypeHouse},
+			input: []byte(`{"house_type":0}`),
+		},
+		{
+			name:  "guildhall int",
+			want:  Test{HouseTypeGuildhall},
+			input: []byte(`{"house_type":1}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var ht Test
+			if err := json.Unmarshal(tc.input, &ht); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if ht != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, ht,
+				)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// KillerTypeFromString converts a string representation of a killer type to
+// its corresponding KillerType.
+//
+// This conversion allows you to work with killer types in a more convenient
+// and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known killer types. If a match is found, the corresponding
+// KillerType is returned along with a nil error.
+//
+// If the provided string does not match any known killer types,
+// an ErrUnknownKillerType is returned.
+//
+// Strings representing the integer value of a KillerType (i.e. "1" for
+// Summon) will also be parsed into their corresponding KillerType.
+func KillerTypeFromString(kt string) (KillerType, error) {
+	switch strings.ToLower(kt) {
+	case "player", "0":
+		return KillerTypePlayer, nil
+	case "summon", "summoned creature", "1":
+		return KillerTypeSummon, nil
+	case "environment", "2":
+		return KillerTypeEnvironment, nil
+	case "monster", "creature", "3":
+		return KillerTypeMonster, nil
+	default:
+		return KillerType{}, ErrUnknownKillerType
+	}
+}
+
+// KillerTypeFromInt converts an integer representation of a killer type to
+// its corresponding KillerType.
+//
+// This conversion allows you to work with killer types in a more convenient
+// and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// killer types. If a match is found, the corresponding KillerType is returned
+// along with a nil error.
+//
+// If the provided integer does not match any known killer types,
+// an ErrUnknownKillerType is returned.
+func KillerTypeFromInt(kt int) (KillerType, error) {
+	switch kt {
+	case 0:
+		return KillerTypePlayer, nil
+	case 1:
+		return KillerTypeSummon, nil
+	case 2:
+		return KillerTypeEnvironment, nil
+	case 3:
+		return KillerTypeMonster, nil
+	default:
+		return KillerType{}, ErrUnknownKillerType
+	}
+}
+
+// KillerType represents what kind of entity took part in the death of a
+// character.
+type KillerType struct {
+	kt int
+}
+
+var (
+	// KillerTypePlayer represents a player killer.
+	KillerTypePlayer = KillerType{0}
+
+	// KillerTypeSummon represents a creature summoned by a player, such as
+	// "a fire elemental of Bubble".
+	KillerTypeSummon = KillerType{1}
+
+	// KillerTypeEnvironment represents a killer that is not a creature, such
+	// as a trap or field damage.
+	KillerTypeEnvironment = KillerType{2}
+
+	// KillerTypeMonster represents a monster killer.
+	KillerTypeMonster = KillerType{3}
+)
+
+// ID returns the integer representation of the KillerType.
+//
+// It can be used to access the numerical representation of the KillerType
+// when needed.
+func (kt KillerType) ID() int {
+	return kt.kt
+}
+
+// String returns the string representation of the KillerType.
+func (kt KillerType) String() string {
+	switch kt {
+	case KillerTypePlayer:
+		return "Player"
+	case KillerTypeSummon:
+		return "Summon"
+	case KillerTypeEnvironment:
+		return "Environment"
+	case KillerTypeMonster:
+		return "Monster"
+	default:
+		panic("unknown kt")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (kt *KillerType) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal killer type: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return kt.unmarshalFromString(v)
+	case float64:
+		return kt.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into killer type", v)
+	}
+}
+
+func (kt *KillerType) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_kt, err := KillerTypeFromString(data)
+	if err != nil {
+		return fmt.Errorf("killer type unmarshal: %w", err)
+	}
+
+	*kt = _kt
+	return nil
+}
+
+func (kt *KillerType) unmarshalFromInt(data int) error {
+	_kt, err := KillerTypeFromInt(data)
+	if err != nil {
+		return fmt.Errorf("killer type unmarshal: %w", err)
+	}
+
+	*kt = _kt
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (kt KillerType) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + kt.String() + `"`), nil
+}
@@ -0,0 +1,110 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestKillerTypeJsonMarshal(t *testing.T) {
+	type Test struct {
+		KT KillerType `json:"killer_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "player",
+			input: Test{KillerTypePlayer},
+			want:  []byte(`{"killer_type":"Player"}`),
+		},
+		{
+			name:  "summon",
+			input: Test{KillerTypeSummon},
+			want:  []byte(`{"killer_type":"Summon"}`),
+		},
+		{
+			name:  "environment",
+			input: Test{KillerTypeEnvironment},
+			want:  []byte(`{"killer_type":"Environment"}`),
+		},
+		{
+			name:  "monster",
+			input: Test{KillerTypeMonster},
+			want:  []byte(`{"killer_type":"Monster"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestKillerTypeJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		KT KillerType `json:"killer_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "player",
+			want:  Test{KillerTypePlayer},
+			input: []byte(`{"killer_type":"Player"}`),
+		},
+		{
+			name:  "summon",
+			want:  Test{KillerTypeSummon},
+			input: []byte(`{"killer_type":"summon"}`),
+		},
+		{
+			name:  "environment str int",
+			want:  Test{KillerTypeEnvironment},
+			input: []byte(`{"killer_type":"2"}`),
+		},
+		{
+			name:  "monster int",
+			want:  Test{KillerTypeMonster},
+			input: []byte(`{"killer_type":3}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var kt Test
+			if err := json.Unmarshal(tc.input, &kt); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if kt != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, kt,
+				)
+				return
+			}
+		})
+	}
+}
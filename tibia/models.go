@@ -29,4 +29,29 @@ type BoostableBosses struct {
 
 	// Bosses is a list of all boostable bosses.
 	Bosses []BoostableBoss `json:"boostable_boss_list"`
+
+	// BoostedCreature is today's boosted creature, read from the header of
+	// the page. It is the zero value if the header could not be parsed.
+	BoostedCreature BoostedCreature `json:"boosted_creature"`
+}
+
+// BoostedCreature represents information about today's boosted creature.
+type BoostedCreature struct {
+	// Name is the name of the creature.
+	Name string `json:"name"`
+
+	// ImageURL is the URL to the image of the creature.
+	ImageURL string `json:"image_url"`
+}
+
+// Boosted represents today's boosted creature and boosted boss.
+//
+// Both are displayed on the header of every tibia.com page, so they can be
+// obtained from any of them.
+type Boosted struct {
+	// Creature is today's boosted creature.
+	Creature BoostedCreature `json:"creature"`
+
+	// Boss is today's boosted boss.
+	Boss BoostableBoss `json:"boss"`
 }
@@ -0,0 +1,61 @@
+package tibia
+
+import "time"
+
+// LatestNews represents the news displayed on the tibia.com Latest News page.
+//
+// The featured news articles and the news ticker entries are displayed in
+// different boxes of the page, so they are kept apart.
+type LatestNews struct {
+	// Articles is a list of the featured news articles, newest first.
+	Articles []News `json:"articles"`
+
+	// Ticker is a list of the news ticker entries, newest first.
+	Ticker []News `json:"ticker"`
+}
+
+// News represents a news entry published on tibia.com.
+type News struct {
+	// ID is the ID of the news entry on the tibia.com News Archive.
+	//
+	// ID is 0 if tibia.com does not link the entry to the News Archive, which
+	// is the case for news ticker entries on the Latest News page.
+	ID int `json:"id,omitempty"`
+
+	// Date is the date the news entry was published.
+	Date time.Time `json:"date"`
+
+	// Category is the category of the news entry.
+	Category NewsCategory `json:"category"`
+
+	// Type is whether the news entry is a news article, a news ticker entry
+	// or a featured article.
+	Type NewsType `json:"type"`
+
+	// Title is the title of the news entry.
+	//
+	// News ticker entries do not have a title, but the News Archive lists them
+	// with the beginning of their text as the title.
+	Title string `json:"title,omitempty"`
+
+	// Body is the text of the news entry, with paragraphs and line breaks
+	// separated by "\n".
+	Body string `json:"body,omitempty"`
+}
+
+// NewsArchive represents the result of a search on the tibia.com News
+// Archive.
+type NewsArchive struct {
+	// Begin is the first day of the searched period.
+	Begin time.Time `json:"begin"`
+
+	// End is the last day of the searched period.
+	End time.Time `json:"end"`
+
+	// News is a list of the news entries published in the searched period
+	// that matched the filters, newest first.
+	//
+	// The News Archive only lists the entries, so Body is not set. Use the ID
+	// to fetch the full entry.
+	News []News `json:"news"`
+}
@@ -0,0 +1,217 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// NewsCategoryFromString converts a string representation of a news category to
+// its corresponding NewsCategory.
+//
+// This conversion allows you to work with news categories in a more convenient
+// and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known news category values. If a match is found, the corresponding
+// NewsCategory is returned along with a nil error.
+//
+// If the provided string does not match any known news category values, an
+// ErrUnknownNewsCategory is returned.
+//
+// Strings representing the integer value of a NewsCategory (i.e. "2" for
+// Development) will also be parsed into their corresponding NewsCategory.
+func NewsCategoryFromString(nc string) (NewsCategory, error) {
+	switch strings.ToLower(nc) {
+	case "cipsoft", "0":
+		return NewsCategoryCipSoft, nil
+	case "community", "1":
+		return NewsCategoryCommunity, nil
+	case "development", "2":
+		return NewsCategoryDevelopment, nil
+	case "support", "3":
+		return NewsCategorySupport, nil
+	case "technical", "technical issues", "4":
+		return NewsCategoryTechnical, nil
+	default:
+		return NewsCategory{}, ErrUnknownNewsCategory
+	}
+}
+
+// NewsCategoryFromInt converts an integer representation of a news category to
+// its corresponding NewsCategory.
+//
+// This conversion allows you to work with news categories in a more convenient
+// and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known news
+// category values. If a match is found, the corresponding NewsCategory is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known news category values, an
+// ErrUnknownNewsCategory is returned.
+func NewsCategoryFromInt(nc int) (NewsCategory, error) {
+	switch nc {
+	case 0:
+		return NewsCategoryCipSoft, nil
+	case 1:
+		return NewsCategoryCommunity, nil
+	case 2:
+		return NewsCategoryDevelopment, nil
+	case 3:
+		return NewsCategorySupport, nil
+	case 4:
+		return NewsCategoryTechnical, nil
+	default:
+		return NewsCategory{}, ErrUnknownNewsCategory
+	}
+}
+
+// NewsCategory represents the category of a tibia.com news entry.
+type NewsCategory struct {
+	nc int
+}
+
+var (
+	// NewsCategoryCipSoft represents news about CipSoft.
+	NewsCategoryCipSoft = NewsCategory{0}
+
+	// NewsCategoryCommunity represents news about the community.
+	NewsCategoryCommunity = NewsCategory{1}
+
+	// NewsCategoryDevelopment represents news about the development of the
+	// game.
+	NewsCategoryDevelopment = NewsCategory{2}
+
+	// NewsCategorySupport represents news from the customer support.
+	NewsCategorySupport = NewsCategory{3}
+
+	// NewsCategoryTechnical represents news about technical issues.
+	NewsCategoryTechnical = NewsCategory{4}
+)
+
+// ID returns the integer representation of the NewsCategory.
+//
+// It can be used to access the numerical representation of the NewsCategory
+// when needed.
+func (nc NewsCategory) ID() int {
+	return nc.nc
+}
+
+// QueryVal returns the form value representation of the NewsCategory.
+//
+// The QueryVal method returns the string representation of the NewsCategory,
+// suitable for use as a form value when searching the tibia.com News Archive.
+//
+// Example usage:
+//
+//	nc := tibia.NewsCategoryDevelopment
+//	vals := url.Values{}
+//	vals.Set(nc.QueryKey(), nc.QueryVal())
+func (nc NewsCategory) QueryVal() string {
+	switch nc {
+	case NewsCategoryCipSoft:
+		return "cipsoft"
+	case NewsCategoryCommunity:
+		return "community"
+	case NewsCategoryDevelopment:
+		return "development"
+	case NewsCategorySupport:
+		return "support"
+	case NewsCategoryTechnical:
+		return "technical"
+	default:
+		panic("unknown nc")
+	}
+}
+
+// QueryKey returns the form key for filtering by NewsCategory.
+//
+// Unlike most filters of tibia.com, the News Archive uses a different key for
+// each news category, so that many of them can be selected at once.
+//
+// Example usage:
+//
+//	nc := tibia.NewsCategoryDevelopment
+//	vals := url.Values{}
+//	vals.Set(nc.QueryKey(), nc.QueryVal())
+func (nc NewsCategory) QueryKey() string {
+	switch nc {
+	case NewsCategoryCipSoft:
+		return "filter_cipsoft"
+	case NewsCategoryCommunity:
+		return "filter_community"
+	case NewsCategoryDevelopment:
+		return "filter_development"
+	case NewsCategorySupport:
+		return "filter_support"
+	case NewsCategoryTechnical:
+		return "filter_technical"
+	default:
+		panic("unknown nc")
+	}
+}
+
+// String returns the string representation of the NewsCategory.
+func (nc NewsCategory) String() string {
+	switch nc {
+	case NewsCategoryCipSoft:
+		return "CipSoft"
+	case NewsCategoryCommunity:
+		return "Community"
+	case NewsCategoryDevelopment:
+		return "Development"
+	case NewsCategorySupport:
+		return "Support"
+	case NewsCategoryTechnical:
+		return "Technical"
+	default:
+		panic("unknown nc")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (nc *NewsCategory) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal news category: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return nc.unmarshalFromString(v)
+	case float64:
+		return nc.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into news category", v)
+	}
+}
+
+func (nc *NewsCategory) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_nc, err := NewsCategoryFromString(data)
+	if err != nil {
+		return fmt.Errorf("news category unmarshal: %w", err)
+	}
+
+	*nc = _nc
+	return nil
+}
+
+func (nc *NewsCategory) unmarshalFromInt(data int) error {
+	_nc, err := NewsCategoryFromInt(data)
+	if err != nil {
+		return fmt.Errorf("news category unmarshal: %w", err)
+	}
+
+	*nc = _nc
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (nc NewsCategory) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + nc.String() + `"`), nil
+}
@@ -0,0 +1,170 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestNewsCategoryJsonMarshal(t *testing.T) {
+	type Test struct {
+		NC NewsCategory `json:"news_category"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "cipsoft",
+			input: Test{NewsCategoryCipSoft},
+			want:  []byte(`{"news_category":"CipSoft"}`),
+		},
+		{
+			name:  "community",
+			input: Test{NewsCategoryCommunity},
+			want:  []byte(`{"news_category":"Community"}`),
+		},
+		{
+			name:  "development",
+			input: Test{NewsCategoryDevelopment},
+			want:  []byte(`{"news_category":"Development"}`),
+		},
+		{
+			name:  "support",
+			input: Test{NewsCategorySupport},
+			want:  []byte(`{"news_category":"Support"}`),
+		},
+		{
+			name:  "technical",
+			input: Test{NewsCategoryTechnical},
+			want:  []byte(`{"news_category":"Technical"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestNewsCategoryJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		NC NewsCategory `json:"news_category"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "cipsoft",
+			want:  Test{NewsCategoryCipSoft},
+			input: []byte(`{"news_category":"CipSoft"}`),
+		},
+		{
+			name:  "community",
+			want:  Test{NewsCategoryCommunity},
+			input: []byte(`{"news_category":"Community"}`),
+		},
+		{
+			name:  "development",
+			want:  Test{NewsCategoryDevelopment},
+			input: []byte(`{"news_category":"Development"}`),
+		},
+		{
+			name:  "support",
+			want:  Test{NewsCategorySupport},
+			input: []byte(`{"news_category":"Support"}`),
+		},
+		{
+			name:  "technical",
+			want:  Test{NewsCategoryTechnical},
+			input: []byte(`{"news_category":"Technical"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "cipsoft str int",
+			want:  Test{NewsCategoryCipSoft},
+			input: []byte(`{"news_category":"0"}`),
+		},
+		{
+			name:  "community str int",
+			want:  Test{NewsCategoryCommunity},
+			input: []byte(`{"news_category":"1"}`),
+		},
+		{
+			name:  "development str int",
+			want:  Test{NewsCategoryDevelopment},
+			input: []byte(`{"news_category":"2"}`),
+		},
+		{
+			name:  "support str int",
+			want:  Test{NewsCategorySupport},
+			input: []byte(`{"news_category":"3"}`),
+		},
+		{
+			name:  "technical str int",
+			want:  Test{NewsCategoryTechnical},
+			input: []byte(`{"news_category":"4"}`),
+		},
+		{
+			name:  "cipsoft int",
+			want:  Test{NewsCategoryCipSoft},
+			input: []byte(`{"news_category":0}`),
+		},
+		{
+			name:  "community int",
+			want:  Test{NewsCategoryCommunity},
+			input: []byte(`{"news_category":1}`),
+		},
+		{
+			name:  "development int",
+			want:  Test{NewsCategoryDevelopment},
+			input: []byte(`{"news_category":2}`),
+		},
+		{
+			name:  "support int",
+			want:  Test{NewsCategorySupport},
+			input: []byte(`{"news_category":3}`),
+		},
+		{
+			name:  "technical int",
+			want:  Test{NewsCategoryTechnical},
+			input: []byte(`{"news_category":4}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var nc Test
+			if err := json.Unmarshal(tc.input, &nc); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if nc != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, nc,
+				)
+				return
+			}
+		})
+	}
+}
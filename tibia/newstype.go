@@ -0,0 +1,190 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// NewsTypeFromString converts a string representation of a news type to its
+// corresponding NewsType.
+//
+// This conversion allows you to work with news types in a more convenient and
+// type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known news type values. If a match is found, the corresponding
+// NewsType is returned along with a nil error.
+//
+// If the provided string does not match any known news type values, an
+// ErrUnknownNewsType is returned.
+//
+// Strings representing the integer value of a NewsType (i.e. "1" for Ticker)
+// will also be parsed into their corresponding NewsType.
+func NewsTypeFromString(nt string) (NewsType, error) {
+	switch strings.ToLower(nt) {
+	case "news", "0":
+		return NewsTypeNews, nil
+	case "news ticker", "ticker", "newsticker", "1":
+		return NewsTypeTicker, nil
+	case "featured article", "featuredarticle", "article", "2":
+		return NewsTypeFeaturedArticle, nil
+	default:
+		return NewsType{}, ErrUnknownNewsType
+	}
+}
+
+// NewsTypeFromInt converts an integer representation of a news type to its
+// corresponding NewsType.
+//
+// This conversion allows you to work with news types in a more convenient and
+// type-safe manner.
+//
+// The function performs a comparison of the provided integer against known news
+// type values. If a match is found, the corresponding NewsType is returned
+// along with a nil error.
+//
+// If the provided integer does not match any known news type values, an
+// ErrUnknownNewsType is returned.
+func NewsTypeFromInt(nt int) (NewsType, error) {
+	switch nt {
+	case 0:
+		return NewsTypeNews, nil
+	case 1:
+		return NewsTypeTicker, nil
+	case 2:
+		return NewsTypeFeaturedArticle, nil
+	default:
+		return NewsType{}, ErrUnknownNewsType
+	}
+}
+
+// NewsType represents the kind of a tibia.com news entry.
+type NewsType struct {
+	nt int
+}
+
+var (
+	// NewsTypeNews represents a regular news article.
+	NewsTypeNews = NewsType{0}
+
+	// NewsTypeTicker represents a short news ticker entry.
+	NewsTypeTicker = NewsType{1}
+
+	// NewsTypeFeaturedArticle represents a featured article.
+	NewsTypeFeaturedArticle = NewsType{2}
+)
+
+// ID returns the integer representation of the NewsType.
+//
+// It can be used to access the numerical representation of the NewsType when
+// needed.
+func (nt NewsType) ID() int {
+	return nt.nt
+}
+
+// QueryVal returns the form value representation of the NewsType.
+//
+// The QueryVal method returns the string representation of the NewsType,
+// suitable for use as a form value when searching the tibia.com News Archive.
+//
+// Example usage:
+//
+//	nt := tibia.NewsTypeTicker
+//	vals := url.Values{}
+//	vals.Set(nt.QueryKey(), nt.QueryVal())
+func (nt NewsType) QueryVal() string {
+	switch nt {
+	case NewsTypeNews:
+		return "news"
+	case NewsTypeTicker:
+		return "ticker"
+	case NewsTypeFeaturedArticle:
+		return "article"
+	default:
+		panic("unknown nt")
+	}
+}
+
+// QueryKey returns the form key for filtering by NewsType.
+//
+// Unlike most filters of tibia.com, the News Archive uses a different key for
+// each news type, so that many of them can be selected at once.
+//
+// Example usage:
+//
+//	nt := tibia.NewsTypeTicker
+//	vals := url.Values{}
+//	vals.Set(nt.QueryKey(), nt.QueryVal())
+func (nt NewsType) QueryKey() string {
+	switch nt {
+	case NewsTypeNews:
+		return "filter_news"
+	case NewsTypeTicker:
+		return "filter_ticker"
+	case NewsTypeFeaturedArticle:
+		return "filter_article"
+	default:
+		panic("unknown nt")
+	}
+}
+
+// String returns the string representation of the NewsType.
+func (nt NewsType) String() string {
+	switch nt {
+	case NewsTypeNews:
+		return "News"
+	case NewsTypeTicker:
+		return "News Ticker"
+	case NewsTypeFeaturedArticle:
+		return "Featured Article"
+	default:
+		panic("unknown nt")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (nt *NewsType) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal news type: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return nt.unmarshalFromString(v)
+	case float64:
+		return nt.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into news type", v)
+	}
+}
+
+func (nt *NewsType) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_nt, err := NewsTypeFromString(data)
+	if err != nil {
+		return fmt.Errorf("news type unmarshal: %w", err)
+	}
+
+	*nt = _nt
+	return nil
+}
+
+func (nt *NewsType) unmarshalFromInt(data int) error {
+	_nt, err := NewsTypeFromInt(data)
+	if err != nil {
+		return fmt.Errorf("news type unmarshal: %w", err)
+	}
+
+	*nt = _nt
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (nt NewsType) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + nt.String() + `"`), nil
+}
@@ -0,0 +1,130 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestNewsTypeJsonMarshal(t *testing.T) {
+	type Test struct {
+		NT NewsType `json:"news_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "news",
+			input: Test{NewsTypeNews},
+			want:  []byte(`{"news_type":"News"}`),
+		},
+		{
+			name:  "news ticker",
+			input: Test{NewsTypeTicker},
+			want:  []byte(`{"news_type":"News Ticker"}`),
+		},
+		{
+			name:  "featured article",
+			input: Test{NewsTypeFeaturedArticle},
+			want:  []byte(`{"news_type":"Featured Article"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestNewsTypeJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		NT NewsType `json:"news_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "news",
+			want:  Test{NewsTypeNews},
+			input: []byte(`{"news_type":"News"}`),
+		},
+		{
+			name:  "news ticker",
+			want:  Test{NewsTypeTicker},
+			input: []byte(`{"news_type":"News Ticker"}`),
+		},
+		{
+			name:  "featured article",
+			want:  Test{NewsTypeFeaturedArticle},
+			input: []byte(`{"news_type":"Featured Article"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "news str int",
+			want:  Test{NewsTypeNews},
+			input: []byte(`{"news_type":"0"}`),
+		},
+		{
+			name:  "news ticker str int",
+			want:  Test{NewsTypeTicker},
+			input: []byte(`{"news_type":"1"}`),
+		},
+		{
+			name:  "featured article str int",
+			want:  Test{NewsTypeFeaturedArticle},
+			input: []byte(`{"news_type":"2"}`),
+		},
+		{
+			name:  "news int",
+			want:  Test{NewsTypeNews},
+			input: []byte(`{"news_type":0}`),
+		},
+		{
+			name:  "news ticker int",
+			want:  Test{NewsTypeTicker},
+			input: []byte(`{"news_type":1}`),
+		},
+		{
+			name:  "featured article int",
+			want:  Test{NewsTypeFeaturedArticle},
+			input: []byte(`{"news_type":2}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var nt Test
+			if err := json.Unmarshal(tc.input, &nt); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if nt != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, nt,
+				)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// OnlineStatusFromString converts a string representation of an online status
+// to its corresponding OnlineStatus.
+//
+// This conversion allows you to work with online statuses in a more convenient
+// and type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known online status values. If a match is found, the corresponding
+// OnlineStatus is returned along with a nil error.
+//
+// If the provided string does not match any known online status values, an
+// ErrUnknownOnlineStatus is returned.
+//
+// Strings representing the integer value of an OnlineStatus (i.e. "1" for
+// Online) will also be parsed into their corresponding OnlineStatus.
+func OnlineStatusFromString(os string) (OnlineStatus, error) {
+	switch strings.ToLower(os) {
+	case "offline", "0":
+		return OnlineStatusOffline, nil
+	case "online", "1":
+		return OnlineStatusOnline, nil
+	default:
+		return OnlineStatus{}, ErrUnknownOnlineStatus
+	}
+}
+
+// OnlineStatusFromInt converts an integer representation of an online status to
+// its corresponding OnlineStatus.
+//
+// This conversion allows you to work with online statuses in a more convenient
+// and type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// online status values. If a match is found, the corresponding OnlineStatus is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known online status values, an
+// ErrUnknownOnlineStatus is returned.
+func OnlineStatusFromInt(os int) (OnlineStatus, error) {
+	switch os {
+	case 0:
+		return OnlineStatusOffline, nil
+	case 1:
+		return OnlineStatusOnline, nil
+	default:
+		return OnlineStatus{}, ErrUnknownOnlineStatus
+	}
+}
+
+// OnlineStatus represents whether a character is currently logged in the game
+// or not.
+type OnlineStatus struct {
+	os int
+}
+
+var (
+	// OnlineStatusOffline represents a character that is not logged in.
+	OnlineStatusOffline = OnlineStatus{0}
+
+	// OnlineStatusOnline represents a character that is logged in.
+	OnlineStatusOnline = OnlineStatus{1}
+)
+
+// ID returns the integer representation of the OnlineStatus.
+//
+// It can be used to access the numerical representation of the OnlineStatus
+// when needed.
+func (os OnlineStatus) ID() int {
+	return os.os
+}
+
+// String returns the string representation of the OnlineStatus.
+func (os OnlineStatus) String() string {
+	switch os {
+	case OnlineStatusOffline:
+		return "Offline"
+	case OnlineStatusOnline:
+		return "Online"
+	default:
+		panic("unknown os")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (os *OnlineStatus) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal online status: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return os.unmarshalFromString(v)
+	case float64:
+		return os.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into online status", v)
+	}
+}
+
+func (os *OnlineStatus) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_os, err := OnlineStatusFromString(data)
+	if err != nil {
+		return fmt.Errorf("online status unmarshal: %w", err)
+	}
+
+	*os = _os
+	return nil
+}
+
+func (os *OnlineStatus) unmarshalFromInt(data int) error {
+	_os, err := OnlineStatusFromInt(data)
+	if err != nil {
+		return fmt.Errorf("online status unmarshal: %w", err)
+	}
+
+	*os = _os
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (os OnlineStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + os.String() + `"`), nil
+}
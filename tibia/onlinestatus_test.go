@@ -0,0 +1,110 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestOnlineStatusJsonMarshal(t *testing.T) {
+	type Test struct {
+		OS OnlineStatus `json:"online_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "offline",
+			input: Test{OnlineStatusOffline},
+			want:  []byte(`{"online_status":"Offline"}`),
+		},
+		{
+			name:  "online",
+			input: Test{OnlineStatusOnline},
+			want:  []byte(`{"online_status":"Online"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestOnlineStatusJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		OS OnlineStatus `json:"online_status"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "offline",
+			want:  Test{OnlineStatusOffline},
+			input: []byte(`{"online_status":"Offline"}`),
+		},
+		{
+			name:  "online",
+			want:  Test{OnlineStatusOnline},
+			input: []byte(`{"online_status":"Online"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "offline str int",
+			want:  Test{OnlineStatusOffline},
+			input: []byte(`{"online_status":"0"}`),
+		},
+		{
+			name:  "online str int",
+			want:  Test{OnlineStatusOnline},
+			input: []byte(`{"online_status":"1"}`),
+		},
+		{
+			name:  "offline int",
+			want:  Test{OnlineStatusOffline},
+			input: []byte(`{"online_status":0}`),
+		},
+		{
+			name:  "online int",
+			want:  Test{OnlineStatusOnline},
+			input: []byte(`{"online_status":1}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var os Test
+			if err := json.Unmarshal(tc.input, &os); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if os != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, os,
+				)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package tibia
+
+import "time"
+
+// ServerSaveTime returns the time of the daily server save on the date of day,
+// in UTC.
+//
+// The server save happens every day at 10:00 German time, which is either CET
+// or CEST, so it is at 09:00 UTC in the winter and at 08:00 UTC in the summer.
+func ServerSaveTime(day time.Time) time.Time {
+	y, m, d := day.Date()
+
+	ss := time.Date(y, m, d, 9, 0, 0, 0, time.UTC)
+	if isCEST(ss) {
+		ss = ss.Add(-time.Hour)
+	}
+
+	return ss
+}
+
+// isCEST reports whether t is within the central european summer time, which
+// starts on the last Sunday of March and ends on the last Sunday of October,
+// both at 01:00 UTC.
+func isCEST(t time.Time) bool {
+	start := lastSunday(t.Year(), time.March).Add(time.Hour)
+	end := lastSunday(t.Year(), time.October).Add(time.Hour)
+	return !t.Before(start) && t.Before(end)
+}
+
+func lastSunday(year int, month time.Month) time.Time {
+	// Day 0 of the next month is the last day of month.
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	return last.AddDate(0, 0, -int(last.Weekday()))
+}
@@ -0,0 +1,53 @@
+package tibia
+
+import (
+	"testing"
+	"time"
+)
+
+func TestServerSaveTime(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		day  time.Time
+		want time.Time
+	}{
+		{
+			name: "winter",
+			day:  time.Date(2023, time.January, 15, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.January, 15, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "summer",
+			day:  time.Date(2023, time.July, 7, 23, 59, 0, 0, time.UTC),
+			want: time.Date(2023, time.July, 7, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "summer time starts",
+			day:  time.Date(2023, time.March, 26, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.March, 26, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day before summer time",
+			day:  time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.March, 25, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "summer time ends",
+			day:  time.Date(2023, time.October, 29, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.October, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day before summer time ends",
+			day:  time.Date(2023, time.October, 28, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2023, time.October, 28, 8, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ServerSaveTime(tc.day); !got.Equal(tc.want) {
+				t.Errorf(
+					"Wrong server save time\nwant: %s\ngot: %s", tc.want, got,
+				)
+			}
+		})
+	}
+}
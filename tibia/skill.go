@@ -0,0 +1,238 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SkillFromString converts a string representation of a skill to its
+// corresponding Skill.
+//
+// This conversion allows you to work with skills in a more convenient and
+// type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known skill values. If a match is found, the corresponding Skill is
+// returned along with a nil error.
+//
+// If the provided string does not match any known skill values, an
+// ErrUnknownSkill is returned.
+//
+// Strings representing the integer value of a Skill (i.e. "3" for Fishing) will
+// also be parsed into their corresponding Skill.
+func SkillFromString(sk string) (Skill, error) {
+	switch strings.ToLower(sk) {
+	case "axe fighting", "axe", "0":
+		return SkillAxeFighting, nil
+	case "club fighting", "club", "1":
+		return SkillClubFighting, nil
+	case "distance fighting", "distance", "2":
+		return SkillDistanceFighting, nil
+	case "fishing", "3":
+		return SkillFishing, nil
+	case "fist fighting", "fist", "4":
+		return SkillFistFighting, nil
+	case "magic level", "magic", "5":
+		return SkillMagicLevel, nil
+	case "shielding", "6":
+		return SkillShielding, nil
+	case "sword fighting", "sword", "7":
+		return SkillSwordFighting, nil
+	default:
+		return Skill{}, ErrUnknownSkill
+	}
+}
+
+// SkillFromInt converts an integer representation of a skill to its
+// corresponding Skill.
+//
+// This conversion allows you to work with skills in a more convenient and
+// type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// skill values. If a match is found, the corresponding Skill is returned along
+// with a nil error.
+//
+// If the provided integer does not match any known skill values, an
+// ErrUnknownSkill is returned.
+func SkillFromInt(sk int) (Skill, error) {
+	switch sk {
+	case 0:
+		return SkillAxeFighting, nil
+	case 1:
+		return SkillClubFighting, nil
+	case 2:
+		return SkillDistanceFighting, nil
+	case 3:
+		return SkillFishing, nil
+	case 4:
+		return SkillFistFighting, nil
+	case 5:
+		return SkillMagicLevel, nil
+	case 6:
+		return SkillShielding, nil
+	case 7:
+		return SkillSwordFighting, nil
+	default:
+		return Skill{}, ErrUnknownSkill
+	}
+}
+
+// Skill represents a skill of a character.
+type Skill struct {
+	sk int
+}
+
+var (
+	// SkillAxeFighting represents the Axe Fighting skill.
+	SkillAxeFighting = Skill{0}
+
+	// SkillClubFighting represents the Club Fighting skill.
+	SkillClubFighting = Skill{1}
+
+	// SkillDistanceFighting represents the Distance Fighting skill.
+	SkillDistanceFighting = Skill{2}
+
+	// SkillFishing represents the Fishing skill.
+	SkillFishing = Skill{3}
+
+	// SkillFistFighting represents the Fist Fighting skill.
+	SkillFistFighting = Skill{4}
+
+	// SkillMagicLevel represents the Magic Level.
+	SkillMagicLevel = Skill{5}
+
+	// SkillShielding represents the Shielding skill.
+	SkillShielding = Skill{6}
+
+	// SkillSwordFighting represents the Sword Fighting skill.
+	SkillSwordFighting = Skill{7}
+)
+
+// ID returns the integer representation of the Skill.
+//
+// It can be used to access the numerical representation of the Skill when
+// needed.
+func (sk Skill) ID() int {
+	return sk.sk
+}
+
+// QueryVal returns the query parameter value representation of the Skill.
+//
+// The QueryVal method returns the string representation of the Skill, suitable
+// for use as a query parameter value when making requests to tibia.com
+// endpoints that support filtering by a skill.
+//
+// Example usage:
+//
+//	sk := tibia.SkillMagicLevel
+//	vals := url.Values{}
+//	vals.Set(sk.QueryKey(), sk.QueryVal())
+func (sk Skill) QueryVal() string {
+	switch sk {
+	case SkillAxeFighting:
+		return "10"
+	case SkillClubFighting:
+		return "9"
+	case SkillDistanceFighting:
+		return "7"
+	case SkillFishing:
+		return "13"
+	case SkillFistFighting:
+		return "11"
+	case SkillMagicLevel:
+		return "1"
+	case SkillShielding:
+		return "6"
+	case SkillSwordFighting:
+		return "8"
+	default:
+		panic("unknown sk")
+	}
+}
+
+// QueryKey returns the query parameter key for filtering by Skill.
+//
+// The QueryKey method returns the string representation of the query parameter
+// key to be used when filtering by a skill in tibia.com requests. This key can
+// be appended to the query string to specify the desired skill.
+//
+// Example usage:
+//
+//	sk := tibia.SkillMagicLevel
+//	vals := url.Values{}
+//	vals.Set(sk.QueryKey(), sk.QueryVal())
+func (sk Skill) QueryKey() string {
+	return "filter_skillid"
+}
+
+// String returns the string representation of the Skill.
+func (sk Skill) String() string {
+	switch sk {
+	case SkillAxeFighting:
+		return "Axe Fighting"
+	case SkillClubFighting:
+		return "Club Fighting"
+	case SkillDistanceFighting:
+		return "Distance Fighting"
+	case SkillFishing:
+		return "Fishing"
+	case SkillFistFighting:
+		return "Fist Fighting"
+	case SkillMagicLevel:
+		return "Magic Level"
+	case SkillShielding:
+		return "Shielding"
+	case SkillSwordFighting:
+		return "Sword Fighting"
+	default:
+		panic("unknown sk")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (sk *Skill) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal skill: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return sk.unmarshalFromString(v)
+	case float64:
+		return sk.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into skill", v)
+	}
+}
+
+func (sk *Skill) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_sk, err := SkillFromString(data)
+	if err != nil {
+		return fmt.Errorf("skill unmarshal: %w", err)
+	}
+
+	*sk = _sk
+	return nil
+}
+
+func (sk *Skill) unmarshalFromInt(data int) error {
+	_sk, err := SkillFromInt(data)
+	if err != nil {
+		return fmt.Errorf("skill unmarshal: %w", err)
+	}
+
+	*sk = _sk
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (sk Skill) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + sk.String() + `"`), nil
+}
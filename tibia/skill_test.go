@@ -0,0 +1,230 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestSkillJsonMarshal(t *testing.T) {
+	type Test struct {
+		SK Skill `json:"skill"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "axe fighting",
+			input: Test{SkillAxeFighting},
+			want:  []byte(`{"skill":"Axe Fighting"}`),
+		},
+		{
+			name:  "club fighting",
+			input: Test{SkillClubFighting},
+			want:  []byte(`{"skill":"Club Fighting"}`),
+		},
+		{
+			name:  "distance fighting",
+			input: Test{SkillDistanceFighting},
+			want:  []byte(`{"skill":"Distance Fighting"}`),
+		},
+		{
+			name:  "fishing",
+			input: Test{SkillFishing},
+			want:  []byte(`{"skill":"Fishing"}`),
+		},
+		{
+			name:  "fist fighting",
+			input: Test{SkillFistFighting},
+			want:  []byte(`{"skill":"Fist Fighting"}`),
+		},
+		{
+			name:  "magic level",
+			input: Test{SkillMagicLevel},
+			want:  []byte(`{"skill":"Magic Level"}`),
+		},
+		{
+			name:  "shielding",
+			input: Test{SkillShielding},
+			want:  []byte(`{"skill":"Shielding"}`),
+		},
+		{
+			name:  "sword fighting",
+			input: Test{SkillSwordFighting},
+			want:  []byte(`{"skill":"Sword Fighting"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestSkillJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		SK Skill `json:"skill"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "axe fighting",
+			want:  Test{SkillAxeFighting},
+			input: []byte(`{"skill":"Axe Fighting"}`),
+		},
+		{
+			name:  "club fighting",
+			want:  Test{SkillClubFighting},
+			input: []byte(`{"skill":"Club Fighting"}`),
+		},
+		{
+			name:  "distance fighting",
+			want:  Test{SkillDistanceFighting},
+			input: []byte(`{"skill":"Distance Fighting"}`),
+		},
+		{
+			name:  "fishing",
+			want:  Test{SkillFishing},
+			input: []byte(`{"skill":"Fishing"}`),
+		},
+		{
+			name:  "fist fighting",
+			want:  Test{SkillFistFighting},
+			input: []byte(`{"skill":"Fist Fighting"}`),
+		},
+		{
+			name:  "magic level",
+			want:  Test{SkillMagicLevel},
+			input: []byte(`{"skill":"Magic Level"}`),
+		},
+		{
+			name:  "shielding",
+			want:  Test{SkillShielding},
+			input: []byte(`{"skill":"Shielding"}`),
+		},
+		{
+			name:  "sword fighting",
+			want:  Test{SkillSwordFighting},
+			input: []byte(`{"skill":"Sword Fighting"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "axe fighting str int",
+			want:  Test{SkillAxeFighting},
+			input: []byte(`{"skill":"0"}`),
+		},
+		{
+			name:  "club fighting str int",
+			want:  Test{SkillClubFighting},
+			input: []byte(`{"skill":"1"}`),
+		},
+		{
+			name:  "distance fighting str int",
+			want:  Test{SkillDistanceFighting},
+			input: []byte(`{"skill":"2"}`),
+		},
+		{
+			name:  "fishing str int",
+			want:  Test{SkillFishing},
+			input: []byte(`{"skill":"3"}`),
+		},
+		{
+			name:  "fist fighting str int",
+			want:  Test{SkillFistFighting},
+			input: []byte(`{"skill":"4"}`),
+		},
+		{
+			name:  "magic level str int",
+			want:  Test{SkillMagicLevel},
+			input: []byte(`{"skill":"5"}`),
+		},
+		{
+			name:  "shielding str int",
+			want:  Test{SkillShielding},
+			input: []byte(`{"skill":"6"}`),
+		},
+		{
+			name:  "sword fighting str int",
+			want:  Test{SkillSwordFighting},
+			input: []byte(`{"skill":"7"}`),
+		},
+		{
+			name:  "axe fighting int",
+			want:  Test{SkillAxeFighting},
+			input: []byte(`{"skill":0}`),
+		},
+		{
+			name:  "club fighting int",
+			want:  Test{SkillClubFighting},
+			input: []byte(`{"skill":1}`),
+		},
+		{
+			name:  "distance fighting int",
+			want:  Test{SkillDistanceFighting},
+			input: []byte(`{"skill":2}`),
+		},
+		{
+			name:  "fishing int",
+			want:  Test{SkillFishing},
+			input: []byte(`{"skill":3}`),
+		},
+		{
+			name:  "fist fighting int",
+			want:  Test{SkillFistFighting},
+			input: []byte(`{"skill":4}`),
+		},
+		{
+			name:  "magic level int",
+			want:  Test{SkillMagicLevel},
+			input: []byte(`{"skill":5}`),
+		},
+		{
+			name:  "shielding int",
+			want:  Test{SkillShielding},
+			input: []byte(`{"skill":6}`),
+		},
+		{
+			name:  "sword fighting int",
+			want:  Test{SkillSwordFighting},
+			input: []byte(`{"skill":7}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var sk Test
+			if err := json.Unmarshal(tc.input, &sk); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if sk != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, sk,
+				)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package tibia
+
+import "time"
+
+// SpellOverview represents the information about a spell displayed on the
+// tibia.com Spells Library page.
+type SpellOverview struct {
+	// ID is the identifier tibia.com uses for the spell, i.e.
+	// "ultimatehealing".
+	ID string `json:"id"`
+
+	// Name is the name of the spell.
+	Name string `json:"name"`
+
+	// Formula is the words that must be said to cast the spell, i.e.
+	// "exura vita".
+	Formula string `json:"formula"`
+
+	// Vocations is a list of the vocations that can cast the spell.
+	//
+	// tibia.com only lists the base vocations, their promotions can cast the
+	// spell as well. See CanCast.
+	Vocations []Vocation `json:"vocations"`
+
+	// Group is the group of the spell.
+	Group SpellGroup `json:"group"`
+
+	// Type is whether the spell is cast directly or conjures a rune.
+	Type SpellType `json:"type"`
+
+	// Level is the experience level required to cast the spell.
+	Level int `json:"level"`
+
+	// Mana is the amount of mana required to cast the spell.
+	Mana int `json:"mana"`
+
+	// Price is the price to learn the spell, in gold coins.
+	Price int `json:"price"`
+
+	// Premium reports whether only premium characters can learn the spell.
+	Premium bool `json:"premium"`
+}
+
+// CanCast reports whether a character of vocation v and the given level can
+// cast the spell.
+//
+// Promoted vocations can cast every spell of their base vocation.
+func (s SpellOverview) CanCast(v Vocation, level int) bool {
+	if level < s.Level {
+		return false
+	}
+
+	for _, vocation := range s.Vocations {
+		if vocation.QueryID() == v.QueryID() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Spell represents the information about a spell displayed on its tibia.com
+// Spells Library page.
+type Spell struct {
+	SpellOverview
+
+	// Description is the description of the spell.
+	Description string `json:"description"`
+
+	// Cooldown is the time that must pass before the spell can be cast again.
+	Cooldown time.Duration `json:"cooldown"`
+
+	// GroupCooldown is the time that must pass before any spell of the same
+	// group can be cast after the spell was cast.
+	GroupCooldown time.Duration `json:"group_cooldown"`
+
+	// SoulPoints is the amount of soul points required to cast the spell.
+	SoulPoints int `json:"soul_points"`
+
+	// Sellers is a list of the NPCs that teach the spell.
+	Sellers []SpellSeller `json:"sellers,omitempty"`
+}
+
+// SpellSeller represents a NPC that teaches a spell.
+type SpellSeller struct {
+	// NPC is the name of the NPC.
+	NPC string `json:"npc"`
+
+	// City is the city the NPC lives in.
+	City string `json:"city"`
+}
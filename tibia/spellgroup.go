@@ -0,0 +1,184 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SpellGroupFromString converts a string representation of a spell group to its
+// corresponding SpellGroup.
+//
+// This conversion allows you to work with spell groups in a more convenient and
+// type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known spell group values. If a match is found, the corresponding
+// SpellGroup is returned along with a nil error.
+//
+// If the provided string does not match any known spell group values, an
+// ErrUnknownSpellGroup is returned.
+//
+// Strings representing the integer value of a SpellGroup (i.e. "1" for Healing)
+// will also be parsed into their corresponding SpellGroup.
+func SpellGroupFromString(sg string) (SpellGroup, error) {
+	switch strings.ToLower(sg) {
+	case "attack", "0":
+		return SpellGroupAttack, nil
+	case "healing", "1":
+		return SpellGroupHealing, nil
+	case "support", "2":
+		return SpellGroupSupport, nil
+	default:
+		return SpellGroup{}, ErrUnknownSpellGroup
+	}
+}
+
+// SpellGroupFromInt converts an integer representation of a spell group to its
+// corresponding SpellGroup.
+//
+// This conversion allows you to work with spell groups in a more convenient and
+// type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// spell group values. If a match is found, the corresponding SpellGroup is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known spell group values, an
+// ErrUnknownSpellGroup is returned.
+func SpellGroupFromInt(sg int) (SpellGroup, error) {
+	switch sg {
+	case 0:
+		return SpellGroupAttack, nil
+	case 1:
+		return SpellGroupHealing, nil
+	case 2:
+		return SpellGroupSupport, nil
+	default:
+		return SpellGroup{}, ErrUnknownSpellGroup
+	}
+}
+
+// SpellGroup represents the group of a spell, which defines the spells that
+// share its group cooldown.
+type SpellGroup struct {
+	sg int
+}
+
+var (
+	// SpellGroupAttack represents the attack spells.
+	SpellGroupAttack = SpellGroup{0}
+
+	// SpellGroupHealing represents the healing spells.
+	SpellGroupHealing = SpellGroup{1}
+
+	// SpellGroupSupport represents the support spells.
+	SpellGroupSupport = SpellGroup{2}
+)
+
+// ID returns the integer representation of the SpellGroup.
+//
+// It can be used to access the numerical representation of the SpellGroup when
+// needed.
+func (sg SpellGroup) ID() int {
+	return sg.sg
+}
+
+// QueryVal returns the query parameter value representation of the SpellGroup.
+//
+// The QueryVal method returns the string representation of the SpellGroup,
+// suitable for use as a query parameter value when making requests to tibia.com
+// endpoints that support filtering by a spell group.
+//
+// Example usage:
+//
+//	sg := tibia.SpellGroupHealing
+//	vals := url.Values{}
+//	vals.Set(sg.QueryKey(), sg.QueryVal())
+func (sg SpellGroup) QueryVal() string {
+	switch sg {
+	case SpellGroupAttack:
+		return "Attack"
+	case SpellGroupHealing:
+		return "Healing"
+	case SpellGroupSupport:
+		return "Support"
+	default:
+		panic("unknown sg")
+	}
+}
+
+// QueryKey returns the query parameter key for filtering by SpellGroup.
+//
+// The QueryKey method returns the string representation of the query parameter
+// key to be used when filtering by a spell group in tibia.com requests. This
+// key can be appended to the query string to specify the desired spell group.
+//
+// Example usage:
+//
+//	sg := tibia.SpellGroupHealing
+//	vals := url.Values{}
+//	vals.Set(sg.QueryKey(), sg.QueryVal())
+func (sg SpellGroup) QueryKey() string {
+	return "group"
+}
+
+// String returns the string representation of the SpellGroup.
+func (sg SpellGroup) String() string {
+	switch sg {
+	case SpellGroupAttack:
+		return "Attack"
+	case SpellGroupHealing:
+		return "Healing"
+	case SpellGroupSupport:
+		return "Support"
+	default:
+		panic("unknown sg")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (sg *SpellGroup) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal spell group: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return sg.unmarshalFromString(v)
+	case float64:
+		return sg.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into spell group", v)
+	}
+}
+
+func (sg *SpellGroup) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_sg, err := SpellGroupFromString(data)
+	if err != nil {
+		return fmt.Errorf("spell group unmarshal: %w", err)
+	}
+
+	*sg = _sg
+	return nil
+}
+
+func (sg *SpellGroup) unmarshalFromInt(data int) error {
+	_sg, err := SpellGroupFromInt(data)
+	if err != nil {
+		return fmt.Errorf("spell group unmarshal: %w", err)
+	}
+
+	*sg = _sg
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (sg SpellGroup) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + sg.String() + `"`), nil
+}
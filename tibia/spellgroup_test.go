@@ -0,0 +1,130 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestSpellGroupJsonMarshal(t *testing.T) {
+	type Test struct {
+		SG SpellGroup `json:"spell_group"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "attack",
+			input: Test{SpellGroupAttack},
+			want:  []byte(`{"spell_group":"Attack"}`),
+		},
+		{
+			name:  "healing",
+			input: Test{SpellGroupHealing},
+			want:  []byte(`{"spell_group":"Healing"}`),
+		},
+		{
+			name:  "support",
+			input: Test{SpellGroupSupport},
+			want:  []byte(`{"spell_group":"Support"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestSpellGroupJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		SG SpellGroup `json:"spell_group"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "attack",
+			want:  Test{SpellGroupAttack},
+			input: []byte(`{"spell_group":"Attack"}`),
+		},
+		{
+			name:  "healing",
+			want:  Test{SpellGroupHealing},
+			input: []byte(`{"spell_group":"Healing"}`),
+		},
+		{
+			name:  "support",
+			want:  Test{SpellGroupSupport},
+			input: []byte(`{"spell_group":"Support"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "attack str int",
+			want:  Test{SpellGroupAttack},
+			input: []byte(`{"spell_group":"0"}`),
+		},
+		{
+			name:  "healing str int",
+			want:  Test{SpellGroupHealing},
+			input: []byte(`{"spell_group":"1"}`),
+		},
+		{
+			name:  "support str int",
+			want:  Test{SpellGroupSupport},
+			input: []byte(`{"spell_group":"2"}`),
+		},
+		{
+			name:  "attack int",
+			want:  Test{SpellGroupAttack},
+			input: []byte(`{"spell_group":0}`),
+		},
+		{
+			name:  "healing int",
+			want:  Test{SpellGroupHealing},
+			input: []byte(`{"spell_group":1}`),
+		},
+		{
+			name:  "support int",
+			want:  Test{SpellGroupSupport},
+			input: []byte(`{"spell_group":2}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var sg Test
+			if err := json.Unmarshal(tc.input, &sg); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if sg != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, sg,
+				)
+				return
+			}
+		})
+	}
+}
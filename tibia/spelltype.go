@@ -0,0 +1,173 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SpellTypeFromString converts a string representation of a spell type to its
+// corresponding SpellType.
+//
+// This conversion allows you to work with spell types in a more convenient and
+// type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known spell type values. If a match is found, the corresponding
+// SpellType is returned along with a nil error.
+//
+// If the provided string does not match any known spell type values, an
+// ErrUnknownSpellType is returned.
+//
+// Strings representing the integer value of a SpellType (i.e. "1" for Rune)
+// will also be parsed into their corresponding SpellType.
+func SpellTypeFromString(st string) (SpellType, error) {
+	switch strings.ToLower(st) {
+	case "instant", "0":
+		return SpellTypeInstant, nil
+	case "rune", "1":
+		return SpellTypeRune, nil
+	default:
+		return SpellType{}, ErrUnknownSpellType
+	}
+}
+
+// SpellTypeFromInt converts an integer representation of a spell type to its
+// corresponding SpellType.
+//
+// This conversion allows you to work with spell types in a more convenient and
+// type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// spell type values. If a match is found, the corresponding SpellType is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known spell type values, an
+// ErrUnknownSpellType is returned.
+func SpellTypeFromInt(st int) (SpellType, error) {
+	switch st {
+	case 0:
+		return SpellTypeInstant, nil
+	case 1:
+		return SpellTypeRune, nil
+	default:
+		return SpellType{}, ErrUnknownSpellType
+	}
+}
+
+// SpellType represents whether a spell is cast directly or used to conjure a
+// rune.
+type SpellType struct {
+	st int
+}
+
+var (
+	// SpellTypeInstant represents the spells that are cast directly.
+	SpellTypeInstant = SpellType{0}
+
+	// SpellTypeRune represents the spells that conjure a rune.
+	SpellTypeRune = SpellType{1}
+)
+
+// ID returns the integer representation of the SpellType.
+//
+// It can be used to access the numerical representation of the SpellType when
+// needed.
+func (st SpellType) ID() int {
+	return st.st
+}
+
+// QueryVal returns the query parameter value representation of the SpellType.
+//
+// The QueryVal method returns the string representation of the SpellType,
+// suitable for use as a query parameter value when making requests to tibia.com
+// endpoints that support filtering by a spell type.
+//
+// Example usage:
+//
+//	st := tibia.SpellTypeRune
+//	vals := url.Values{}
+//	vals.Set(st.QueryKey(), st.QueryVal())
+func (st SpellType) QueryVal() string {
+	switch st {
+	case SpellTypeInstant:
+		return "Instant"
+	case SpellTypeRune:
+		return "Rune"
+	default:
+		panic("unknown st")
+	}
+}
+
+// QueryKey returns the query parameter key for filtering by SpellType.
+//
+// The QueryKey method returns the string representation of the query parameter
+// key to be used when filtering by a spell type in tibia.com requests. This key
+// can be appended to the query string to specify the desired spell type.
+//
+// Example usage:
+//
+//	st := tibia.SpellTypeRune
+//	vals := url.Values{}
+//	vals.Set(st.QueryKey(), st.QueryVal())
+func (st SpellType) QueryKey() string {
+	return "type"
+}
+
+// String returns the string representation of the SpellType.
+func (st SpellType) String() string {
+	switch st {
+	case SpellTypeInstant:
+		return "Instant"
+	case SpellTypeRune:
+		return "Rune"
+	default:
+		panic("unknown st")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (st *SpellType) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal spell type: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return st.unmarshalFromString(v)
+	case float64:
+		return st.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into spell type", v)
+	}
+}
+
+func (st *SpellType) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_st, err := SpellTypeFromString(data)
+	if err != nil {
+		return fmt.Errorf("spell type unmarshal: %w", err)
+	}
+
+	*st = _st
+	return nil
+}
+
+func (st *SpellType) unmarshalFromInt(data int) error {
+	_st, err := SpellTypeFromInt(data)
+	if err != nil {
+		return fmt.Errorf("spell type unmarshal: %w", err)
+	}
+
+	*st = _st
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (st SpellType) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + st.String() + `"`), nil
+}
@@ -0,0 +1,110 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestSpellTypeJsonMarshal(t *testing.T) {
+	type Test struct {
+		ST SpellType `json:"spell_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "instant",
+			input: Test{SpellTypeInstant},
+			want:  []byte(`{"spell_type":"Instant"}`),
+		},
+		{
+			name:  "rune",
+			input: Test{SpellTypeRune},
+			want:  []byte(`{"spell_type":"Rune"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestSpellTypeJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		ST SpellType `json:"spell_type"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "instant",
+			want:  Test{SpellTypeInstant},
+			input: []byte(`{"spell_type":"Instant"}`),
+		},
+		{
+			name:  "rune",
+			want:  Test{SpellTypeRune},
+			input: []byte(`{"spell_type":"Rune"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "instant str int",
+			want:  Test{SpellTypeInstant},
+			input: []byte(`{"spell_type":"0"}`),
+		},
+		{
+			name:  "rune str int",
+			want:  Test{SpellTypeRune},
+			input: []byte(`{"spell_type":"1"}`),
+		},
+		{
+			name:  "instant int",
+			want:  Test{SpellTypeInstant},
+			input: []byte(`{"spell_type":0}`),
+		},
+		{
+			name:  "rune int",
+			want:  Test{SpellTypeRune},
+			input: []byte(`{"spell_type":1}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var st Test
+			if err := json.Unmarshal(tc.input, &st); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if st != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, st,
+				)
+				return
+			}
+		})
+	}
+}
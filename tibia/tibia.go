@@ -42,6 +42,14 @@ const (
 	//
 	// This value is the same for both new and legacy names.
 	MinRunesAllowedInCharWord = 2
+
+	// MaxRunesAllowedInWorldName is the maximum amount of runes allowed in a
+	// world name.
+	MaxRunesAllowedInWorldName = 16
+
+	// MinRunesAllowedInWorldName is the minimum amount of runes allowed in a
+	// world name.
+	MinRunesAllowedInWorldName = 3
 )
 
 var (
@@ -60,4 +68,67 @@ var (
 	// ErrUnknownPvPType will be used when an uknown PvP type was tried to
 	// be parsed.
 	ErrUnknownPvPType = errors.New("unknown pvp type")
+
+	// ErrUnknownKillerType will be used when an uknown killer type was tried
+	// to be parsed.
+	ErrUnknownKillerType = errors.New("unknown killer type")
+
+	// ErrUnknownOnlineStatus will be used when an uknown online status was
+	// tried to be parsed.
+	ErrUnknownOnlineStatus = errors.New("unknown online status")
+
+	// ErrUnknownDeletionStatus will be used when an uknown deletion status was
+	// tried to be parsed.
+	ErrUnknownDeletionStatus = errors.New("unknown deletion status")
+
+	// ErrUnknownVisibility will be used when an uknown visibility was tried to
+	// be parsed.
+	ErrUnknownVisibility = errors.New("unknown visibility")
+
+	// ErrUnknownGuildWarEndReason will be used when an uknown guild war end
+	// reason was tried to be parsed.
+	ErrUnknownGuildWarEndReason = errors.New("unknown guild war end reason")
+
+	// ErrUnknownTown will be used when an uknown town was tried to be parsed.
+	ErrUnknownTown = errors.New("unknown town")
+
+	// ErrUnknownHouseType will be used when an uknown house type was tried to
+	// be parsed.
+	ErrUnknownHouseType = errors.New("unknown house type")
+
+	// ErrUnknownHouseStatus will be used when an uknown house status was tried
+	// to be parsed.
+	ErrUnknownHouseStatus = errors.New("unknown house status")
+
+	// ErrUnknownNewsCategory will be used when an uknown news category was
+	// tried to be parsed.
+	ErrUnknownNewsCategory = errors.New("unknown news category")
+
+	// ErrUnknownNewsType will be used when an uknown news type was tried to be
+	// parsed.
+	ErrUnknownNewsType = errors.New("unknown news type")
+
+	// ErrUnknownSpellGroup will be used when an uknown spell group was tried
+	// to be parsed.
+	ErrUnknownSpellGroup = errors.New("unknown spell group")
+
+	// ErrUnknownSpellType will be used when an uknown spell type was tried to
+	// be parsed.
+	ErrUnknownSpellType = errors.New("unknown spell type")
+
+	// ErrUnknownSkill will be used when an uknown skill was tried to be
+	// parsed.
+	ErrUnknownSkill = errors.New("unknown skill")
+
+	// ErrUnknownAuctionOrder will be used when an uknown auction order was
+	// tried to be parsed.
+	ErrUnknownAuctionOrder = errors.New("unknown auction order")
+
+	// ErrUnknownAuctionBidStatus will be used when an uknown auction bid
+	// status was tried to be parsed.
+	ErrUnknownAuctionBidStatus = errors.New("unknown auction bid status")
+
+	// ErrUnknownAuctionOutcome will be used when an uknown auction outcome
+	// was tried to be parsed.
+	ErrUnknownAuctionOutcome = errors.New("unknown auction outcome")
 )
@@ -0,0 +1,309 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TownFromString converts a string representation of a town to its
+// corresponding Town.
+//
+// This conversion allows you to work with towns in a more convenient and
+// type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known town values. If a match is found, the corresponding Town is
+// returned along with a nil error.
+//
+// If the provided string does not match any known town values, an
+// ErrUnknownTown is returned.
+//
+// Strings representing the integer value of a Town (i.e. "15" for Thais) will
+// also be parsed into their corresponding Town.
+func TownFromString(tn string) (Town, error) {
+	switch strings.ToLower(tn) {
+	case "ab'dendriel", "abdendriel", "ab dendriel", "0":
+		return TownAbDendriel, nil
+	case "ankrahmun", "1":
+		return TownAnkrahmun, nil
+	case "carlin", "2":
+		return TownCarlin, nil
+	case "darashia", "3":
+		return TownDarashia, nil
+	case "edron", "4":
+		return TownEdron, nil
+	case "farmine", "5":
+		return TownFarmine, nil
+	case "gray beach", "graybeach", "6":
+		return TownGrayBeach, nil
+	case "issavi", "7":
+		return TownIssavi, nil
+	case "kazordoon", "8":
+		return TownKazordoon, nil
+	case "liberty bay", "libertybay", "9":
+		return TownLibertyBay, nil
+	case "moonfall", "10":
+		return TownMoonfall, nil
+	case "port hope", "porthope", "11":
+		return TownPortHope, nil
+	case "rathleton", "12":
+		return TownRathleton, nil
+	case "silvertides", "13":
+		return TownSilvertides, nil
+	case "svargrond", "14":
+		return TownSvargrond, nil
+	case "thais", "15":
+		return TownThais, nil
+	case "venore", "16":
+		return TownVenore, nil
+	case "yalahar", "17":
+		return TownYalahar, nil
+	default:
+		return Town{}, ErrUnknownTown
+	}
+}
+
+// TownFromInt converts an integer representation of a town to its corresponding
+// Town.
+//
+// This conversion allows you to work with towns in a more convenient and
+// type-safe manner.
+//
+// The function performs a comparison of the provided integer against known town
+// values. If a match is found, the corresponding Town is returned along with a
+// nil error.
+//
+// If the provided integer does not match any known town values, an
+// ErrUnknownTown is returned.
+func TownFromInt(tn int) (Town, error) {
+	switch tn {
+	case 0:
+		return TownAbDendriel, nil
+	case 1:
+		return TownAnkrahmun, nil
+	case 2:
+		return TownCarlin, nil
+	case 3:
+		return TownDarashia, nil
+	case 4:
+		return TownEdron, nil
+	case 5:
+		return TownFarmine, nil
+	case 6:
+		return TownGrayBeach, nil
+	case 7:
+		return TownIssavi, nil
+	case 8:
+		return TownKazordoon, nil
+	case 9:
+		return TownLibertyBay, nil
+	case 10:
+		return TownMoonfall, nil
+	case 11:
+		return TownPortHope, nil
+	case 12:
+		return TownRathleton, nil
+	case 13:
+		return TownSilvertides, nil
+	case 14:
+		return TownSvargrond, nil
+	case 15:
+		return TownThais, nil
+	case 16:
+		return TownVenore, nil
+	case 17:
+		return TownYalahar, nil
+	default:
+		return Town{}, ErrUnknownTown
+	}
+}
+
+// Town represents a town of the Tibia map.
+type Town struct {
+	tn int
+}
+
+var (
+	// TownAbDendriel represents the Ab'Dendriel town.
+	TownAbDendriel = Town{0}
+
+	// TownAnkrahmun represents the Ankrahmun town.
+	TownAnkrahmun = Town{1}
+
+	// TownCarlin represents the Carlin town.
+	TownCarlin = Town{2}
+
+	// TownDarashia represents the Darashia town.
+	TownDarashia = Town{3}
+
+	// TownEdron represents the Edron town.
+	TownEdron = Town{4}
+
+	// TownFarmine represents the Farmine town.
+	TownFarmine = Town{5}
+
+	// TownGrayBeach represents the Gray Beach town.
+	TownGrayBeach = Town{6}
+
+	// TownIssavi represents the Issavi town.
+	TownIssavi = Town{7}
+
+	// TownKazordoon represents the Kazordoon town.
+	TownKazordoon = Town{8}
+
+	// TownLibertyBay represents the Liberty Bay town.
+	TownLibertyBay = Town{9}
+
+	// TownMoonfall represents the Moonfall town.
+	TownMoonfall = Town{10}
+
+	// TownPortHope represents the Port Hope town.
+	TownPortHope = Town{11}
+
+	// TownRathleton represents the Rathleton town.
+	TownRathleton = Town{12}
+
+	// TownSilvertides represents the Silvertides town.
+	TownSilvertides = Town{13}
+
+	// TownSvargrond represents the Svargrond town.
+	TownSvargrond = Town{14}
+
+	// TownThais represents the Thais town.
+	TownThais = Town{15}
+
+	// TownVenore represents the Venore town.
+	TownVenore = Town{16}
+
+	// TownYalahar represents the Yalahar town.
+	TownYalahar = Town{17}
+)
+
+// ID returns the integer representation of the Town.
+//
+// It can be used to access the numerical representation of the Town when
+// needed.
+func (tn Town) ID() int {
+	return tn.tn
+}
+
+// QueryVal returns the query parameter value representation of the Town.
+//
+// The QueryVal method returns the string representation of the Town, suitable
+// for use as a query parameter value when making requests to tibia.com
+// endpoints that support filtering by a town.
+//
+// Example usage:
+//
+//	tn := tibia.TownThais
+//	vals := url.Values{}
+//	vals.Set(tn.QueryKey(), tn.QueryVal())
+func (tn Town) QueryVal() string {
+	return tn.String()
+}
+
+// QueryKey returns the query parameter key for filtering by Town.
+//
+// The QueryKey method returns the string representation of the query parameter
+// key to be used when filtering by a town in tibia.com requests. This key can
+// be appended to the query string to specify the desired town.
+//
+// Example usage:
+//
+//	tn := tibia.TownThais
+//	vals := url.Values{}
+//	vals.Set(tn.QueryKey(), tn.QueryVal())
+func (tn Town) QueryKey() string {
+	return "town"
+}
+
+// String returns the string representation of the Town.
+func (tn Town) String() string {
+	switch tn {
+	case TownAbDendriel:
+		return "Ab'Dendriel"
+	case TownAnkrahmun:
+		return "Ankrahmun"
+	case TownCarlin:
+		return "Carlin"
+	case TownDarashia:
+		return "Darashia"
+	case TownEdron:
+		return "Edron"
+	case TownFarmine:
+		return "Farmine"
+	case TownGrayBeach:
+		return "Gray Beach"
+	case TownIssavi:
+		return "Issavi"
+	case TownKazordoon:
+		return "Kazordoon"
+	case TownLibertyBay:
+		return "Liberty Bay"
+	case TownMoonfall:
+		return "Moonfall"
+	case TownPortHope:
+		return "Port Hope"
+	case TownRathleton:
+		return "Rathleton"
+	case TownSilvertides:
+		return "Silvertides"
+	case TownSvargrond:
+		return "Svargrond"
+	case TownThais:
+		return "Thais"
+	case TownVenore:
+		return "Venore"
+	case TownYalahar:
+		return "Yalahar"
+	default:
+		panic("unknown tn")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (tn *Town) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal town: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return tn.unmarshalFromString(v)
+	case float64:
+		return tn.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into town", v)
+	}
+}
+
+func (tn *Town) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_tn, err := TownFromString(data)
+	if err != nil {
+		return fmt.Errorf("town unmarshal: %w", err)
+	}
+
+	*tn = _tn
+	return nil
+}
+
+func (tn *Town) unmarshalFromInt(data int) error {
+	_tn, err := TownFromInt(data)
+	if err != nil {
+		return fmt.Errorf("town unmarshal: %w", err)
+	}
+
+	*tn = _tn
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (tn Town) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + tn.String() + `"`), nil
+}
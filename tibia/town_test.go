@@ -0,0 +1,430 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestTownJsonMarshal(t *testing.T) {
+	type Test struct {
+		TN Town `json:"town"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "ab'dendriel",
+			input: Test{TownAbDendriel},
+			want:  []byte(`{"town":"Ab'Dendriel"}`),
+		},
+		{
+			name:  "ankrahmun",
+			input: Test{TownAnkrahmun},
+			want:  []byte(`{"town":"Ankrahmun"}`),
+		},
+		{
+			name:  "carlin",
+			input: Test{TownCarlin},
+			want:  []byte(`{"town":"Carlin"}`),
+		},
+		{
+			name:  "darashia",
+			input: Test{TownDarashia},
+			want:  []byte(`{"town":"Darashia"}`),
+		},
+		{
+			name:  "edron",
+			input: Test{TownEdron},
+			want:  []byte(`{"town":"Edron"}`),
+		},
+		{
+			name:  "farmine",
+			input: Test{TownFarmine},
+			want:  []byte(`{"town":"Farmine"}`),
+		},
+		{
+			name:  "gray beach",
+			input: Test{TownGrayBeach},
+			want:  []byte(`{"town":"Gray Beach"}`),
+		},
+		{
+			name:  "issavi",
+			input: Test{TownIssavi},
+			want:  []byte(`{"town":"Issavi"}`),
+		},
+		{
+			name:  "kazordoon",
+			input: Test{TownKazordoon},
+			want:  []byte(`{"town":"Kazordoon"}`),
+		},
+		{
+			name:  "liberty bay",
+			input: Test{TownLibertyBay},
+			want:  []byte(`{"town":"Liberty Bay"}`),
+		},
+		{
+			name:  "moonfall",
+			input: Test{TownMoonfall},
+			want:  []byte(`{"town":"Moonfall"}`),
+		},
+		{
+			name:  "port hope",
+			input: Test{TownPortHope},
+			want:  []byte(`{"town":"Port Hope"}`),
+		},
+		{
+			name:  "rathleton",
+			input: Test{TownRathleton},
+			want:  []byte(`{"town":"Rathleton"}`),
+		},
+		{
+			name:  "silvertides",
+			input: Test{TownSilvertides},
+			want:  []byte(`{"town":"Silvertides"}`),
+		},
+		{
+			name:  "svargrond",
+			input: Test{TownSvargrond},
+			want:  []byte(`{"town":"Svargrond"}`),
+		},
+		{
+			name:  "thais",
+			input: Test{TownThais},
+			want:  []byte(`{"town":"Thais"}`),
+		},
+		{
+			name:  "venore",
+			input: Test{TownVenore},
+			want:  []byte(`{"town":"Venore"}`),
+		},
+		{
+			name:  "yalahar",
+			input: Test{TownYalahar},
+			want:  []byte(`{"town":"Yalahar"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestTownJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		TN Town `json:"town"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "ab'dendriel",
+			want:  Test{TownAbDendriel},
+			input: []byte(`{"town":"Ab'Dendriel"}`),
+		},
+		{
+			name:  "ankrahmun",
+			want:  Test{TownAnkrahmun},
+			input: []byte(`{"town":"Ankrahmun"}`),
+		},
+		{
+			name:  "carlin",
+			want:  Test{TownCarlin},
+			input: []byte(`{"town":"Carlin"}`),
+		},
+		{
+			name:  "darashia",
+			want:  Test{TownDarashia},
+			input: []byte(`{"town":"Darashia"}`),
+		},
+		{
+			name:  "edron",
+			want:  Test{TownEdron},
+			input: []byte(`{"town":"Edron"}`),
+		},
+		{
+			name:  "farmine",
+			want:  Test{TownFarmine},
+			input: []byte(`{"town":"Farmine"}`),
+		},
+		{
+			name:  "gray beach",
+			want:  Test{TownGrayBeach},
+			input: []byte(`{"town":"Gray Beach"}`),
+		},
+		{
+			name:  "issavi",
+			want:  Test{TownIssavi},
+			input: []byte(`{"town":"Issavi"}`),
+		},
+		{
+			name:  "kazordoon",
+			want:  Test{TownKazordoon},
+			input: []byte(`{"town":"Kazordoon"}`),
+		},
+		{
+			name:  "liberty bay",
+			want:  Test{TownLibertyBay},
+			input: []byte(`{"town":"Liberty Bay"}`),
+		},
+		{
+			name:  "moonfall",
+			want:  Test{TownMoonfall},
+			input: []byte(`{"town":"Moonfall"}`),
+		},
+		{
+			name:  "port hope",
+			want:  Test{TownPortHope},
+			input: []byte(`{"town":"Port Hope"}`),
+		},
+		{
+			name:  "rathleton",
+			want:  Test{TownRathleton},
+			input: []byte(`{"town":"Rathleton"}`),
+		},
+		{
+			name:  "silvertides",
+			want:  Test{TownSilvertides},
+			input: []byte(`{"town":"Silvertides"}`),
+		},
+		{
+			name:  "svargrond",
+			want:  Test{TownSvargrond},
+			input: []byte(`{"town":"Svargrond"}`),
+		},
+		{
+			name:  "thais",
+			want:  Test{TownThais},
+			input: []byte(`{"town":"Thais"}`),
+		},
+		{
+			name:  "venore",
+			want:  Test{TownVenore},
+			input: []byte(`{"town":"Venore"}`),
+		},
+		{
+			name:  "yalahar",
+			want:  Test{TownYalahar},
+			input: []byte(`{"town":"Yalahar"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "ab'dendriel str int",
+			want:  Test{TownAbDendriel},
+			input: []byte(`{"town":"0"}`),
+		},
+		{
+			name:  "ankrahmun str int",
+			want:  Test{TownAnkrahmun},
+			input: []byte(`{"town":"1"}`),
+		},
+		{
+			name:  "carlin str int",
+			want:  Test{TownCarlin},
+			input: []byte(`{"town":"2"}`),
+		},
+		{
+			name:  "darashia str int",
+			want:  Test{TownDarashia},
+			input: []byte(`{"town":"3"}`),
+		},
+		{
+			name:  "edron str int",
+			want:  Test{TownEdron},
+			input: []byte(`{"town":"4"}`),
+		},
+		{
+			name:  "farmine str int",
+			want:  Test{TownFarmine},
+			input: []byte(`{"town":"5"}`),
+		},
+		{
+			name:  "gray beach str int",
+			want:  Test{TownGrayBeach},
+			input: []byte(`{"town":"6"}`),
+		},
+		{
+			name:  "issavi str int",
+			want:  Test{TownIssavi},
+			input: []byte(`{"town":"7"}`),
+		},
+		{
+			name:  "kazordoon str int",
+			want:  Test{TownKazordoon},
+			input: []byte(`{"town":"8"}`),
+		},
+		{
+			name:  "liberty bay str int",
+			want:  Test{TownLibertyBay},
+			input: []byte(`{"town":"9"}`),
+		},
+		{
+			name:  "moonfall str int",
+			want:  Test{TownMoonfall},
+			input: []byte(`{"town":"10"}`),
+		},
+		{
+			name:  "port hope str int",
+			want:  Test{TownPortHope},
+			input: []byte(`{"town":"11"}`),
+		},
+		{
+			name:  "rathleton str int",
+			want:  Test{TownRathleton},
+			input: []byte(`{"town":"12"}`),
+		},
+		{
+			name:  "silvertides str int",
+			want:  Test{TownSilvertides},
+			input: []byte(`{"town":"13"}`),
+		},
+		{
+			name:  "svargrond str int",
+			want:  Test{TownSvargrond},
+			input: []byte(`{"town":"14"}`),
+		},
+		{
+			name:  "thais str int",
+			want:  Test{TownThais},
+			input: []byte(`{"town":"15"}`),
+		},
+		{
+			name:  "venore str int",
+			want:  Test{TownVenore},
+			input: []byte(`{"town":"16"}`),
+		},
+		{
+			name:  "yalahar str int",
+			want:  Test{TownYalahar},
+			input: []byte(`{"town":"17"}`),
+		},
+		{
+			name:  "ab'dendriel int",
+			want:  Test{TownAbDendriel},
+			input: []byte(`{"town":0}`),
+		},
+		{
+			name:  "ankrahmun int",
+			want:  Test{TownAnkrahmun},
+			input: []byte(`{"town":1}`),
+		},
+		{
+			name:  "carlin int",
+			want:  Test{TownCarlin},
+			input: []byte(`{"town":2}`),
+		},
+		{
+			name:  "darashia int",
+			want:  Test{TownDarashia},
+			input: []byte(`{"town":3}`),
+		},
+		{
+			name:  "edron int",
+			want:  Test{TownEdron},
+			input: []byte(`{"town":4}`),
+		},
+		{
+			name:  "farmine int",
+			want:  Test{TownFarmine},
+			input: []byte(`{"town":5}`),
+		},
+		{
+			name:  "gray beach int",
+			want:  Test{TownGrayBeach},
+			input: []byte(`{"town":6}`),
+		},
+		{
+			name:  "issavi int",
+			want:  Test{TownIssavi},
+			input: []byte(`{"town":7}`),
+		},
+		{
+			name:  "kazordoon int",
+			want:  Test{TownKazordoon},
+			input: []byte(`{"town":8}`),
+		},
+		{
+			name:  "liberty bay int",
+			want:  Test{TownLibertyBay},
+			input: []byte(`{"town":9}`),
+		},
+		{
+			name:  "moonfall int",
+			want:  Test{TownMoonfall},
+			input: []byte(`{"town":10}`),
+		},
+		{
+			name:  "port hope int",
+			want:  Test{TownPortHope},
+			input: []byte(`{"town":11}`),
+		},
+		{
+			name:  "rathleton int",
+			want:  Test{TownRathleton},
+			input: []byte(`{"town":12}`),
+		},
+		{
+			name:  "silvertides int",
+			want:  Test{TownSilvertides},
+			input: []byte(`{"town":13}`),
+		},
+		{
+			name:  "svargrond int",
+			want:  Test{TownSvargrond},
+			input: []byte(`{"town":14}`),
+		},
+		{
+			name:  "thais int",
+			want:  Test{TownThais},
+			input: []byte(`{"town":15}`),
+		},
+		{
+			name:  "venore int",
+			want:  Test{TownVenore},
+			input: []byte(`{"town":16}`),
+		},
+		{
+			name:  "yalahar int",
+			want:  Test{TownYalahar},
+			input: []byte(`{"town":17}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var tn Test
+			if err := json.Unmarshal(tc.input, &tn); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if tn != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, tn,
+				)
+				return
+			}
+		})
+	}
+}
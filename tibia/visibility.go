@@ -0,0 +1,136 @@
+package tibia
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// VisibilityFromString converts a string representation of a visibility to its
+// corresponding Visibility.
+//
+// This conversion allows you to work with visibilities in a more convenient and
+// type-safe manner.
+//
+// The function performs a case-insensitive comparison of the provided string
+// against known visibility values. If a match is found, the corresponding
+// Visibility is returned along with a nil error.
+//
+// If the provided string does not match any known visibility values, an
+// ErrUnknownVisibility is returned.
+//
+// Strings representing the integer value of a Visibility (i.e. "1" for Hidden)
+// will also be parsed into their corresponding Visibility.
+func VisibilityFromString(vs string) (Visibility, error) {
+	switch strings.ToLower(vs) {
+	case "visible", "public", "0":
+		return VisibilityVisible, nil
+	case "hidden", "private", "1":
+		return VisibilityHidden, nil
+	default:
+		return Visibility{}, ErrUnknownVisibility
+	}
+}
+
+// VisibilityFromInt converts an integer representation of a visibility to its
+// corresponding Visibility.
+//
+// This conversion allows you to work with visibilities in a more convenient and
+// type-safe manner.
+//
+// The function performs a comparison of the provided integer against known
+// visibility values. If a match is found, the corresponding Visibility is
+// returned along with a nil error.
+//
+// If the provided integer does not match any known visibility values, an
+// ErrUnknownVisibility is returned.
+func VisibilityFromInt(vs int) (Visibility, error) {
+	switch vs {
+	case 0:
+		return VisibilityVisible, nil
+	case 1:
+		return VisibilityHidden, nil
+	default:
+		return Visibility{}, ErrUnknownVisibility
+	}
+}
+
+// Visibility represents whether the owner of an account chose to hide its
+// information, such as the other characters of the account, on tibia.com.
+type Visibility struct {
+	vs int
+}
+
+var (
+	// VisibilityVisible represents information that is displayed on tibia.com.
+	VisibilityVisible = Visibility{0}
+
+	// VisibilityHidden represents information that is hidden on tibia.com.
+	VisibilityHidden = Visibility{1}
+)
+
+// ID returns the integer representation of the Visibility.
+//
+// It can be used to access the numerical representation of the Visibility when
+// needed.
+func (vs Visibility) ID() int {
+	return vs.vs
+}
+
+// String returns the string representation of the Visibility.
+func (vs Visibility) String() string {
+	switch vs {
+	case VisibilityVisible:
+		return "Visible"
+	case VisibilityHidden:
+		return "Hidden"
+	default:
+		panic("unknown vs")
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (vs *Visibility) UnmarshalJSON(b []byte) error {
+	var zero any
+	if err := json.Unmarshal(b, &zero); err != nil {
+		return fmt.Errorf("failed to unmarshal visibility: %w", err)
+	}
+
+	switch v := zero.(type) {
+	case string:
+		return vs.unmarshalFromString(v)
+	case float64:
+		return vs.unmarshalFromInt(int(v))
+	default:
+		return fmt.Errorf("can not unmarshal %T into visibility", v)
+	}
+}
+
+func (vs *Visibility) unmarshalFromString(data string) error {
+	if data == "" {
+		return nil
+	}
+
+	_vs, err := VisibilityFromString(data)
+	if err != nil {
+		return fmt.Errorf("visibility unmarshal: %w", err)
+	}
+
+	*vs = _vs
+	return nil
+}
+
+func (vs *Visibility) unmarshalFromInt(data int) error {
+	_vs, err := VisibilityFromInt(data)
+	if err != nil {
+		return fmt.Errorf("visibility unmarshal: %w", err)
+	}
+
+	*vs = _vs
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (vs Visibility) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + vs.String() + `"`), nil
+}
@@ -0,0 +1,110 @@
+package tibia
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestVisibilityJsonMarshal(t *testing.T) {
+	type Test struct {
+		VS Visibility `json:"visibility"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input Test
+		want  []byte
+	}{
+		{
+			name:  "visible",
+			input: Test{VisibilityVisible},
+			want:  []byte(`{"visibility":"Visible"}`),
+		},
+		{
+			name:  "hidden",
+			input: Test{VisibilityHidden},
+			want:  []byte(`{"visibility":"Hidden"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Errorf("failed to marshal json: %s", err)
+				return
+			}
+
+			if !bytes.Equal(tc.want, data) {
+				t.Errorf(
+					"unexpected marshal result\nwant: %s\ngot: %s\n",
+					string(tc.want), string(data),
+				)
+				return
+			}
+		})
+	}
+}
+
+func TestVisibilityJsonUnmarshal(t *testing.T) {
+	type Test struct {
+		VS Visibility `json:"visibility"`
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input []byte
+		want  Test
+	}{
+		{
+			name:  "visible",
+			want:  Test{VisibilityVisible},
+			input: []byte(`{"visibility":"Visible"}`),
+		},
+		{
+			name:  "hidden",
+			want:  Test{VisibilityHidden},
+			input: []byte(`{"visibility":"Hidden"}`),
+		},
+		{
+			name:  "empty",
+			want:  Test{},
+			input: []byte(`{}`),
+		},
+		{
+			name:  "visible str int",
+			want:  Test{VisibilityVisible},
+			input: []byte(`{"visibility":"0"}`),
+		},
+		{
+			name:  "hidden str int",
+			want:  Test{VisibilityHidden},
+			input: []byte(`{"visibility":"1"}`),
+		},
+		{
+			name:  "visible int",
+			want:  Test{VisibilityVisible},
+			input: []byte(`{"visibility":0}`),
+		},
+		{
+			name:  "hidden int",
+			want:  Test{VisibilityHidden},
+			input: []byte(`{"visibility":1}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var vs Test
+			if err := json.Unmarshal(tc.input, &vs); err != nil {
+				t.Errorf("failed to unmarshal json: %s", err)
+				return
+			}
+
+			if vs != tc.want {
+				t.Errorf(
+					"unexpected unmarshal result\nwant: %#v\ngot: %#v\n",
+					tc.want, vs,
+				)
+				return
+			}
+		})
+	}
+}
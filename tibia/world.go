@@ -0,0 +1,200 @@
+package tibia
+
+import (
+	"strings"
+	"time"
+)
+
+// Worlds represents the overview of all game worlds.
+//
+// The Worlds struct contains the amount of players online across all worlds,
+// the overall players online record and a list of all game worlds. This
+// information is typically obtained from the tibia.com Game Worlds page.
+type Worlds struct {
+	// PlayersOnline is the amount of players currently online across all
+	// worlds.
+	PlayersOnline int `json:"players_online"`
+
+	// RecordPlayers is the maximum amount of players ever online at the same
+	// time across all worlds.
+	RecordPlayers int `json:"record_players"`
+
+	// RecordTime is when RecordPlayers was reached.
+	RecordTime time.Time `json:"record_time"`
+
+	// Worlds is a list of all game worlds, including tournament worlds.
+	Worlds []WorldOverview `json:"worlds"`
+}
+
+// WorldOverview represents the information about a world displayed on the
+// tibia.com Game Worlds page.
+type WorldOverview struct {
+	// Name is the name of the world.
+	Name string `json:"name"`
+
+	// Status is the online status of the world.
+	Status OnlineStatus `json:"status"`
+
+	// PlayersOnline is the amount of players currently online on the world.
+	PlayersOnline int `json:"players_online"`
+
+	// Location is the location of the world servers, i.e. "Europe".
+	Location string `json:"location"`
+
+	// PvPType is the PvP type of the world.
+	PvPType PvPType `json:"pvp_type"`
+
+	// BattleEye is the Battle Eye status of the world.
+	BattleEye BattleEyeStatus `json:"battle_eye"`
+
+	// BattleEyeSince is when the world started being protected by Battle
+	// Eye.
+	//
+	// BattleEyeSince is only set if BattleEye is BattleEyeStatusProtected.
+	BattleEyeSince time.Time `json:"battle_eye_since"`
+
+	// PremiumOnly reports whether only premium accounts can play on the
+	// world.
+	PremiumOnly bool `json:"premium_only"`
+
+	// TransferLocked reports whether characters can only be transferred out
+	// of the world.
+	TransferLocked bool `json:"transfer_locked"`
+
+	// TransferBlocked reports whether characters can not be transferred to
+	// the world.
+	TransferBlocked bool `json:"transfer_blocked"`
+
+	// Experimental reports whether the world is an experimental world.
+	Experimental bool `json:"experimental"`
+
+	// Tournament reports whether the world is a tournament world.
+	Tournament bool `json:"tournament"`
+}
+
+// World represents the information about a world displayed on the tibia.com
+// World Information page.
+type World struct {
+	// Name is the name of the world.
+	Name string `json:"name"`
+
+	// Status is the online status of the world.
+	Status OnlineStatus `json:"status"`
+
+	// PlayersOnline is the amount of players currently online on the world.
+	PlayersOnline int `json:"players_online"`
+
+	// RecordPlayers is the maximum amount of players ever online at the same
+	// time on the world.
+	RecordPlayers int `json:"record_players"`
+
+	// RecordTime is when RecordPlayers was reached.
+	RecordTime time.Time `json:"record_time"`
+
+	// CreationDate is the month the world was created in.
+	CreationDate time.Time `json:"creation_date"`
+
+	// Location is the location of the world servers, i.e. "Europe".
+	Location string `json:"location"`
+
+	// PvPType is the PvP type of the world.
+	PvPType PvPType `json:"pvp_type"`
+
+	// PremiumOnly reports whether only premium accounts can play on the
+	// world.
+	PremiumOnly bool `json:"premium_only"`
+
+	// TransferLocked reports whether characters can only be transferred out
+	// of the world.
+	TransferLocked bool `json:"transfer_locked"`
+
+	// TransferBlocked reports whether characters can not be transferred to
+	// the world.
+	TransferBlocked bool `json:"transfer_blocked"`
+
+	// QuestTitles is a list of the world quest titles earned by the world.
+	QuestTitles []string `json:"quest_titles,omitempty"`
+
+	// BattleEye is the Battle Eye status of the world.
+	BattleEye BattleEyeStatus `json:"battle_eye"`
+
+	// BattleEyeSince is when the world started being protected by Battle
+	// Eye.
+	//
+	// BattleEyeSince is only set if BattleEye is BattleEyeStatusProtected.
+	BattleEyeSince time.Time `json:"battle_eye_since"`
+
+	// Experimental reports whether the world is an experimental world.
+	Experimental bool `json:"experimental"`
+
+	// Tournament reports whether the world is a tournament world.
+	Tournament bool `json:"tournament"`
+
+	// OnlinePlayers is a list of the players currently online on the world.
+	OnlinePlayers []OnlinePlayer `json:"online_players"`
+}
+
+// OnlinePlayer represents a player that is online on a world.
+type OnlinePlayer struct {
+	// Name is the name of the player.
+	Name string `json:"name"`
+
+	// Level is the level of the player.
+	Level int `json:"level"`
+
+	// Vocation is the vocation of the player.
+	Vocation Vocation `json:"vocation"`
+}
+
+// KillStatistics represents the kill statistics of a world.
+//
+// The KillStatistics struct contains, for every race, how many players it
+// killed and how many times it was killed by players on the last day and on
+// the last week. This information is typically obtained from the tibia.com
+// Kill Statistics page.
+type KillStatistics struct {
+	// World is the world the kill statistics are from.
+	World string `json:"world"`
+
+	// Entries is a list of the kill statistics of every race, in the same
+	// order they are displayed on the page.
+	Entries []KillStatisticsEntry `json:"entries"`
+
+	// Total is the sum of the kill statistics of all races.
+	Total KillStatisticsEntry `json:"total"`
+}
+
+// Race returns the kill statistics of the given race.
+//
+// The comparison is case-insensitive. If the race is not found, false is
+// returned.
+func (ks KillStatistics) Race(race string) (KillStatisticsEntry, bool) {
+	for _, entry := range ks.Entries {
+		if strings.EqualFold(entry.Race, race) {
+			return entry, true
+		}
+	}
+	return KillStatisticsEntry{}, false
+}
+
+// KillStatisticsEntry represents the kill statistics of a race.
+type KillStatisticsEntry struct {
+	// Race is the name of the race, i.e. "dragon lords".
+	Race string `json:"race"`
+
+	// LastDayKilledPlayers is the amount of players killed by the race on
+	// the last day.
+	LastDayKilledPlayers int `json:"last_day_killed_players"`
+
+	// LastDayKilledByPlayers is the amount of times the race was killed by
+	// players on the last day.
+	LastDayKilledByPlayers int `json:"last_day_killed_by_players"`
+
+	// LastWeekKilledPlayers is the amount of players killed by the race on
+	// the last week.
+	LastWeekKilledPlayers int `json:"last_week_killed_players"`
+
+	// LastWeekKilledByPlayers is the amount of times the race was killed by
+	// players on the last week.
+	LastWeekKilledByPlayers int `json:"last_week_killed_by_players"`
+}
@@ -0,0 +1,33 @@
+package tibia
+
+import "unicode/utf8"
+
+// IsWorldNameValid checks if the provided name is a valid world name.
+//
+// World names are made of a single word of ASCII letters, in which only the
+// first letter is capitalized, such as Antica.
+//
+// Note that IsWorldNameValid does not check whether the world exists. Use the
+// worlds parser for that.
+func IsWorldNameValid(name string) bool {
+	runeCount := utf8.RuneCountInString(name)
+	if runeCount > MaxRunesAllowedInWorldName ||
+		runeCount < MinRunesAllowedInWorldName {
+		return false
+	}
+
+	for i, r := range name {
+		if i == 0 {
+			if r < 'A' || r > 'Z' {
+				return false
+			}
+			continue
+		}
+
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+
+	return true
+}